@@ -62,6 +62,21 @@ func (c *Category) Variables(ctx context.Context) ([]*Variable, error) {
 	return res, nil
 }
 
+func (c *Category) Records(ctx context.Context, args RecordsArgs) ([]*Leaderboard, error) {
+	filters := speedrun.WithFilters(args)
+	embeds := withEmbeds(args.Embed, planLeaderboardEmbeds(selectionFromContext(ctx)))
+	lbs, _, err := c.client.ListCategoryRecords(ctx, c.Category.ID, filters, embeds, speedrun.WithLimit(maxRecords))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Leaderboard
+	for _, lb := range lbs {
+		res = append(res, &Leaderboard{*lb, c.client, []speedrun.FetchOption{filters}})
+	}
+	return res, nil
+}
+
 func (c *Category) Runs(ctx context.Context, args FetchRunsArgs) (*RunConnection, error) {
 	if args.Filter != nil && args.Filter.Category != nil {
 		return nil, errors.New("cannot filter runs by category when reading from a specific category")
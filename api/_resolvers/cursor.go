@@ -3,8 +3,10 @@ package resolvers
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 type Cursor string
@@ -32,6 +34,53 @@ func (c *Cursor) UnmarshalGraphQL(input interface{}) error {
 	return fmt.Errorf("cursor was not a string")
 }
 
-func (c *Cursor) GetOffset() (int, error) {
-	return strconv.Atoi(string(*c))
+// newCursor creates a cursor for the item at offset in the list identified
+// by fingerprint.
+func newCursor(offset int, fingerprint string) Cursor {
+	return Cursor(fmt.Sprintf("%d:%s", offset, fingerprint))
+}
+
+// GetOffset reads the offset from the cursor, making sure that it was created
+// for the list identified by fingerprint.
+//
+// Cursors from before lists had fingerprints held only an offset, which was
+// the start of the next page rather than the offset of an item. These are
+// rejected, since there's no telling which list they came from.
+func (c *Cursor) GetOffset(fingerprint string) (int, error) {
+	s := string(*c)
+	i := strings.IndexByte(s, ':')
+	if i < 0 || s[i+1:] != fingerprint {
+		return 0, errors.New("cursor is not valid for this list")
+	}
+
+	offset, err := strconv.Atoi(s[:i])
+	if err != nil || offset < 0 {
+		return 0, errors.New("cursor is not valid for this list")
+	}
+	return offset, nil
+}
+
+const placeCursorPrefix = "place:"
+
+// newPlaceCursor creates a cursor for a run on the leaderboard identified by
+// fingerprint. Since runs can tie, tie is the index of the run among those
+// sharing its place.
+func newPlaceCursor(place int, tie int, fingerprint string) Cursor {
+	return Cursor(fmt.Sprintf("%s%d.%d:%s", placeCursorPrefix, place, tie, fingerprint))
+}
+
+// GetPlace reads the place and tie index from a cursor for a leaderboard run,
+// making sure that it was created for the leaderboard identified by fingerprint.
+func (c *Cursor) GetPlace(fingerprint string) (int, int, error) {
+	s := string(*c)
+	i := strings.LastIndexByte(s, ':')
+	if !strings.HasPrefix(s, placeCursorPrefix) || i < len(placeCursorPrefix) || s[i+1:] != fingerprint {
+		return 0, 0, errors.New("cursor is not valid for this list")
+	}
+
+	var place, tie int
+	if _, err := fmt.Sscanf(s[len(placeCursorPrefix):i], "%d.%d", &place, &tie); err != nil {
+		return 0, 0, errors.New("cursor is not valid for this list")
+	}
+	return place, tie, nil
 }
@@ -0,0 +1,97 @@
+package resolvers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCursorGetOffset(t *testing.T) {
+	tests := []struct {
+		cursor  Cursor
+		want    int
+		wantErr bool
+	}{
+		{newCursor(0, "abcd1234"), 0, false},
+		{newCursor(42, "abcd1234"), 42, false},
+		{newCursor(42, "ffff0000"), 0, true},
+		{"42", 0, true},
+		{"42:", 0, true},
+		{":abcd1234", 0, true},
+		{"x:abcd1234", 0, true},
+		{"-1:abcd1234", 0, true},
+		{newPlaceCursor(3, 0, "abcd1234"), 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.cursor.GetOffset("abcd1234")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("GetOffset(%q) error = %v, want error %v", tt.cursor, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GetOffset(%q) = %d, want %d", tt.cursor, got, tt.want)
+		}
+	}
+}
+
+func TestCursorGetPlace(t *testing.T) {
+	tests := []struct {
+		cursor  Cursor
+		place   int
+		tie     int
+		wantErr bool
+	}{
+		{newPlaceCursor(1, 0, "abcd1234"), 1, 0, false},
+		{newPlaceCursor(12, 3, "abcd1234"), 12, 3, false},
+		{newPlaceCursor(12, 3, "ffff0000"), 0, 0, true},
+		{"place:12.3", 0, 0, true},
+		{"place:x.3:abcd1234", 0, 0, true},
+		{newCursor(12, "abcd1234"), 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		place, tie, err := tt.cursor.GetPlace("abcd1234")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("GetPlace(%q) error = %v, want error %v", tt.cursor, err, tt.wantErr)
+			continue
+		}
+		if place != tt.place || tie != tt.tie {
+			t.Errorf("GetPlace(%q) = %d, %d, want %d, %d", tt.cursor, place, tie, tt.place, tt.tie)
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	c := newCursor(7, "abcd1234")
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Cursor
+	if err := decoded.UnmarshalGraphQL(s); err != nil {
+		t.Fatal(err)
+	}
+	if offset, err := decoded.GetOffset("abcd1234"); err != nil || offset != 7 {
+		t.Errorf("GetOffset() after round trip = %d, %v, want 7", offset, err)
+	}
+}
+
+func TestLegacyCursorRejected(t *testing.T) {
+	s := newTestServer(t)
+
+	// Old cursors were the bare offset of the next page, base64 encoded.
+	res, n := s.exec(t, `{ viewer { games(first: 1, after: "MQ==") { edges { node { name } } } } }`, nil)
+	if len(res.Errors) != 1 || res.Errors[0].Message != "cursor is not valid for this list" {
+		t.Errorf("errors = %+v, want the cursor to be rejected", res.Errors)
+	}
+	if n != 0 {
+		t.Errorf("made %d requests, want 0", n)
+	}
+}
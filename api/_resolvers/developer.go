@@ -0,0 +1,123 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (v *Viewer) Developers(ctx context.Context, args struct {
+	Order *struct {
+		Field     *DeveloperOrderField
+		Direction *speedrun.OrderDirection
+	}
+	PageArgs
+}) (*DeveloperConnection, error) {
+	var opts []speedrun.FetchOption
+	if args.Order != nil {
+		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
+	}
+
+	var developers []*speedrun.Developer
+	page, err := args.fetch(ctx, "developer", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		developers, pi, err = v.client.ListDevelopers(ctx, opts...)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeveloperConnection{
+		client:     v.client,
+		developers: developers,
+		page:       page,
+	}, nil
+}
+
+type DeveloperOrderField string
+
+func (DeveloperOrderField) ImplementsGraphQLType(name string) bool {
+	return name == "DeveloperOrderField"
+}
+
+func (v *DeveloperOrderField) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("DeveloperOrderField value was not a string")
+	}
+
+	*v = DeveloperOrderField(strings.ToLower(s))
+	return nil
+}
+
+type DeveloperConnection struct {
+	client     *speedrun.Client
+	developers []*speedrun.Developer
+	page       *listPage
+}
+
+func (dc *DeveloperConnection) Edges() []*DeveloperEdge {
+	var edges []*DeveloperEdge
+	for i, d := range dc.developers {
+		edges = append(edges, &DeveloperEdge{
+			Node:   &Developer{*d, dc.client},
+			cursor: dc.page.cursor(i),
+		})
+	}
+	return edges
+}
+
+func (dc *DeveloperConnection) Nodes() []*Developer {
+	var nodes []*Developer
+	for _, d := range dc.developers {
+		nodes = append(nodes, &Developer{*d, dc.client})
+	}
+	return nodes
+}
+
+func (dc *DeveloperConnection) PageInfo() *PageInfo {
+	return dc.page.pageInfo()
+}
+
+func (dc *DeveloperConnection) TotalCount() (*int32, error) {
+	return dc.page.totalCount()
+}
+
+func (dc *DeveloperConnection) PageSize() int32 {
+	return dc.page.pageSize()
+}
+
+type DeveloperEdge struct {
+	Node   *Developer
+	cursor Cursor
+}
+
+func (e *DeveloperEdge) Cursor() Cursor {
+	return e.cursor
+}
+
+type Developer struct {
+	speedrun.Developer
+	client *speedrun.Client
+}
+
+func (d *Developer) ID() graphql.ID {
+	return relay.MarshalID("developer", d.Developer.ID)
+}
+
+func (d *Developer) RawID() string {
+	return d.Developer.ID
+}
+
+func (d *Developer) Games(ctx context.Context, args FetchGamesArgs) (*GameConnection, error) {
+	if args.Filter != nil && args.Filter.Developer != nil {
+		return nil, errors.New("cannot filter games by developer when reading from a specific developer")
+	}
+
+	return fetchGameConnection(ctx, d.client, args, speedrun.WithFilter("developer", d.Developer.ID))
+}
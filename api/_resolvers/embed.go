@@ -0,0 +1,117 @@
+package resolvers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+// RunEmbed selects resources related to runs that should be fetched along with
+// them, saving a request for each one when they are resolved later.
+type RunEmbed speedrun.Embed
+
+func (RunEmbed) ImplementsGraphQLType(name string) bool {
+	return name == "RunEmbed"
+}
+
+func (v *RunEmbed) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("RunEmbed value was not a string")
+	}
+
+	switch s {
+	case "GAME":
+		*v = RunEmbed(speedrun.EmbedGame)
+	case "CATEGORY":
+		*v = RunEmbed(speedrun.EmbedCategory)
+	case "LEVEL":
+		*v = RunEmbed(speedrun.EmbedLevel)
+	case "PLAYERS":
+		*v = RunEmbed(speedrun.EmbedPlayers)
+	default:
+		return fmt.Errorf("unknown RunEmbed value %q", s)
+	}
+
+	return nil
+}
+
+// withEmbeds embeds the resources asked for with an embed argument, along with
+// any that were planned from the selection set.
+func withEmbeds(embeds *[]RunEmbed, planned []speedrun.Embed) speedrun.FetchOption {
+	var es []speedrun.Embed
+	if embeds != nil {
+		for _, e := range *embeds {
+			es = append(es, speedrun.Embed(e))
+		}
+	}
+	es = append(es, planned...)
+
+	seen := make(map[speedrun.Embed]bool)
+	var unique []speedrun.Embed
+	for _, e := range es {
+		if !seen[e] {
+			seen[e] = true
+			unique = append(unique, e)
+		}
+	}
+	return speedrun.WithEmbed(unique...)
+}
+
+// planRunEmbeds chooses the embeds needed to resolve the fields selected on
+// runs without a request for each run.
+func planRunEmbeds(runs ...*selectionSet) []speedrun.Embed {
+	var es []speedrun.Embed
+	for _, run := range runs {
+		if run.has("game") {
+			es = append(es, speedrun.EmbedGame)
+		}
+		if run.has("category") {
+			es = append(es, speedrun.EmbedCategory)
+		}
+		if run.has("level") {
+			es = append(es, speedrun.EmbedLevel)
+		}
+		if run.has("players", "user") {
+			es = append(es, speedrun.EmbedPlayers)
+		}
+	}
+	return es
+}
+
+// planRunConnectionEmbeds chooses embeds for the runs in a RunConnection.
+func planRunConnectionEmbeds(sel *selectionSet) []speedrun.Embed {
+	return planRunEmbeds(sel.field("nodes"), sel.field("edges", "node"))
+}
+
+// planLeaderboardEmbeds chooses embeds for a leaderboard. Leaderboards embed
+// resources once for the whole board rather than in each run, and all of the
+// runs share a game and category.
+func planLeaderboardEmbeds(sel *selectionSet) []speedrun.Embed {
+	runs := []*selectionSet{
+		sel.field("runs", "nodes", "run"),
+		sel.field("runs", "edges", "node", "run"),
+	}
+
+	es := planRunEmbeds(runs...)
+	if sel.has("game") {
+		es = append(es, speedrun.EmbedGame)
+	}
+	if sel.has("category") {
+		es = append(es, speedrun.EmbedCategory)
+	}
+	if sel.has("level") {
+		es = append(es, speedrun.EmbedLevel)
+	}
+	for _, run := range runs {
+		if run.has("values") || run.has("value") {
+			es = append(es, speedrun.EmbedVariables)
+		}
+	}
+	// Runs are filtered by country using their players' locations.
+	if sel.field("runs").hasArg("country") {
+		es = append(es, speedrun.EmbedPlayers)
+	}
+	return es
+}
@@ -0,0 +1,46 @@
+package resolvers
+
+import (
+	"errors"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+const (
+	ErrorCodeNotFound      = "NOT_FOUND"
+	ErrorCodeRateLimited   = "RATE_LIMITED"
+	ErrorCodeUnauthorized  = "UNAUTHORIZED"
+	ErrorCodeUpstreamError = "UPSTREAM_ERROR"
+)
+
+// errorExtensions describes an error from a resolver in a way clients can
+// react to without matching on the message. Errors that didn't come from
+// speedrun.com have no extensions.
+func errorExtensions(err error) map[string]interface{} {
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *speedrun.APIError
+	if !errors.As(err, &apiErr) {
+		if errors.Is(err, speedrun.ErrNotFound) {
+			return map[string]interface{}{"code": ErrorCodeNotFound}
+		}
+		return nil
+	}
+
+	code := ErrorCodeUpstreamError
+	if errors.Is(apiErr, speedrun.ErrNotFound) {
+		code = ErrorCodeNotFound
+	} else if apiErr.RateLimited() {
+		code = ErrorCodeRateLimited
+	} else if apiErr.Unauthorized() {
+		code = ErrorCodeUnauthorized
+	}
+
+	return map[string]interface{}{
+		"code":   code,
+		"status": apiErr.StatusCode,
+		"url":    apiErr.URL,
+	}
+}
@@ -0,0 +1,72 @@
+package resolvers
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestErrorExtensions(t *testing.T) {
+	tests := []struct {
+		status int
+		code   string
+	}{
+		{http.StatusNotFound, ErrorCodeNotFound},
+		{420, ErrorCodeRateLimited},
+		{http.StatusTooManyRequests, ErrorCodeRateLimited},
+		{http.StatusUnauthorized, ErrorCodeUnauthorized},
+		{http.StatusForbidden, ErrorCodeUnauthorized},
+		{http.StatusInternalServerError, ErrorCodeUpstreamError},
+		{http.StatusBadGateway, ErrorCodeUpstreamError},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.status), func(t *testing.T) {
+			s := newTestServer(t)
+			s.Fail("/games/sm64/categories", tt.status)
+
+			res, _ := s.exec(t, `query($id: ID!) { game(id: $id) { name categories { name } } }`,
+				map[string]interface{}{"id": relay.MarshalID("game", "sm64")})
+			if len(res.Errors) != 1 {
+				t.Fatalf("got errors %+v, want 1", res.Errors)
+			}
+
+			want := map[string]interface{}{
+				"code":   tt.code,
+				"status": float64(tt.status),
+				"url":    s.BaseURL() + "/games/sm64/categories",
+			}
+			if got := res.Errors[0].Extensions; !reflect.DeepEqual(got, want) {
+				t.Errorf("extensions = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestErrorExtensionsInvalidAPIKey(t *testing.T) {
+	s := newTestServer(t)
+	s.apiKey = "wrong"
+
+	res, _ := s.exec(t, `{ me { name } }`, nil)
+	if len(res.Errors) != 1 {
+		t.Fatalf("got errors %+v, want 1", res.Errors)
+	}
+	if got := res.Errors[0].Extensions["code"]; got != ErrorCodeUnauthorized {
+		t.Errorf("code = %v, want %s", got, ErrorCodeUnauthorized)
+	}
+}
+
+func TestErrorExtensionsOtherErrors(t *testing.T) {
+	s := newTestServer(t)
+
+	res, _ := s.exec(t, `{ viewer { games(first: -1) { nodes { name } } } }`, nil)
+	if len(res.Errors) != 1 {
+		t.Fatalf("got errors %+v, want 1", res.Errors)
+	}
+	if got := res.Errors[0].Extensions; got != nil {
+		t.Errorf("extensions = %v, want none", got)
+	}
+}
@@ -24,6 +24,10 @@ func (r *Resolvers) Game(ctx context.Context, args struct{ ID graphql.ID }) (*Ga
 		return nil, err
 	}
 
+	if game == nil {
+		return nil, nil
+	}
+
 	return &Game{*game, r.client}, nil
 }
 
@@ -49,11 +53,19 @@ type FetchGamesArgs struct {
 		Field     *GameOrderField
 		Direction *speedrun.OrderDirection
 	}
-	First *int32
-	After *Cursor
+	PageArgs
 }
 
 func fetchGameConnection(ctx context.Context, c *speedrun.Client, args FetchGamesArgs, extraOpts ...speedrun.FetchOption) (*GameConnection, error) {
+	return fetchGameList(ctx, c, "game", c.ListGames, args, extraOpts...)
+}
+
+type listGamesFunc func(ctx context.Context, opts ...speedrun.FetchOption) ([]*speedrun.Game, *speedrun.PageInfo, error)
+
+// fetchGameList fetches a connection from a list of games other than the
+// main games list, like the games in a series. Cursors are only valid for the
+// list named by kind.
+func fetchGameList(ctx context.Context, c *speedrun.Client, kind string, listGames listGamesFunc, args FetchGamesArgs, extraOpts ...speedrun.FetchOption) (*GameConnection, error) {
 	var opts []speedrun.FetchOption
 	opts = append(opts, extraOpts...)
 	if args.Order != nil {
@@ -62,26 +74,20 @@ func fetchGameConnection(ctx context.Context, c *speedrun.Client, args FetchGame
 	if args.Filter != nil {
 		opts = append(opts, speedrun.WithFilters(args.Filter))
 	}
-	if args.First != nil {
-		opts = append(opts, speedrun.WithLimit(int(*args.First)))
-	}
-	if args.After != nil {
-		offset, err := args.After.GetOffset()
-		if err != nil {
-			return nil, err
-		}
-		opts = append(opts, speedrun.WithOffset(offset))
-	}
 
-	games, pageInfo, err := c.ListGames(ctx, opts...)
+	var games []*speedrun.Game
+	page, err := args.fetch(ctx, kind, opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		games, pi, err = listGames(ctx, opts...)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &GameConnection{
-		client:   c,
-		games:    games,
-		pageInfo: pageInfo,
+		client: c,
+		games:  games,
+		page:   page,
 	}, nil
 }
 
@@ -109,16 +115,17 @@ func (v *GameOrderField) UnmarshalGraphQL(input interface{}) error {
 }
 
 type GameConnection struct {
-	client   *speedrun.Client
-	games    []*speedrun.Game
-	pageInfo *speedrun.PageInfo
+	client *speedrun.Client
+	games  []*speedrun.Game
+	page   *listPage
 }
 
 func (gc *GameConnection) Edges() []*GameEdge {
 	var edges []*GameEdge
-	for _, g := range gc.games {
+	for i, g := range gc.games {
 		edges = append(edges, &GameEdge{
-			Node: &Game{*g, gc.client},
+			Node:   &Game{*g, gc.client},
+			cursor: gc.page.cursor(i),
 		})
 	}
 	return edges
@@ -133,15 +140,24 @@ func (gc *GameConnection) Nodes() []*Game {
 }
 
 func (gc *GameConnection) PageInfo() *PageInfo {
-	return &PageInfo{gc.pageInfo}
+	return gc.page.pageInfo()
+}
+
+func (gc *GameConnection) TotalCount() (*int32, error) {
+	return gc.page.totalCount()
+}
+
+func (gc *GameConnection) PageSize() int32 {
+	return gc.page.pageSize()
 }
 
 type GameEdge struct {
-	Node *Game
+	Node   *Game
+	cursor Cursor
 }
 
-func (*GameEdge) Cursor() *Cursor {
-	return nil
+func (e *GameEdge) Cursor() Cursor {
+	return e.cursor
 }
 
 type Game struct {
@@ -242,6 +258,88 @@ func (g *Game) Engines(ctx context.Context) ([]*Engine, error) {
 	return res, nil
 }
 
+func (g *Game) GameTypes(ctx context.Context) ([]*GameType, error) {
+	types, err := g.client.GetGameTypes(ctx, g.Game.GameTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*GameType
+	for _, gt := range types {
+		res = append(res, &GameType{*gt, g.client})
+	}
+	return res, nil
+}
+
+func (g *Game) Developers(ctx context.Context) ([]*Developer, error) {
+	devs, err := g.client.GetDevelopers(ctx, g.Game.Developers)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Developer
+	for _, dev := range devs {
+		res = append(res, &Developer{*dev, g.client})
+	}
+	return res, nil
+}
+
+func (g *Game) Publishers(ctx context.Context) ([]*Publisher, error) {
+	pubs, err := g.client.GetPublishers(ctx, g.Game.Publishers)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Publisher
+	for _, pub := range pubs {
+		res = append(res, &Publisher{*pub, g.client})
+	}
+	return res, nil
+}
+
+func (g *Game) Series(ctx context.Context) (*Series, error) {
+	seriesURI := speedrun.FindLink(g.Links, "series")
+	if seriesURI == "" {
+		return nil, nil
+	}
+
+	series, err := g.client.GetSeries(ctx, seriesURI)
+	if err != nil {
+		return nil, err
+	}
+
+	if series == nil {
+		return nil, nil
+	}
+
+	return &Series{*series, g.client}, nil
+}
+
+func (g *Game) Parent(ctx context.Context) (*Game, error) {
+	parentURI := speedrun.FindLink(g.Links, "base-game")
+	if parentURI == "" {
+		return nil, nil
+	}
+
+	parent, err := g.client.GetGame(ctx, parentURI)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent == nil {
+		return nil, nil
+	}
+
+	return &Game{*parent, g.client}, nil
+}
+
+func (g *Game) DerivedGames(ctx context.Context, args FetchGamesArgs) (*GameConnection, error) {
+	listGames := func(ctx context.Context, opts ...speedrun.FetchOption) ([]*speedrun.Game, *speedrun.PageInfo, error) {
+		return g.client.ListDerivedGames(ctx, g.Game.ID, opts...)
+	}
+	return fetchGameList(ctx, g.client, "derived-game:"+g.Game.ID, listGames, args)
+}
+
 func (g *Game) Moderators() []*GameModerator {
 	var gms []*GameModerator
 	for userID, role := range g.Game.Moderators {
@@ -324,6 +422,21 @@ func (g *Game) Variables(ctx context.Context) ([]*Variable, error) {
 	return res, nil
 }
 
+func (g *Game) Records(ctx context.Context, args RecordsArgs) ([]*Leaderboard, error) {
+	filters := speedrun.WithFilters(args)
+	embeds := withEmbeds(args.Embed, planLeaderboardEmbeds(selectionFromContext(ctx)))
+	lbs, _, err := g.client.ListGameRecords(ctx, g.Game.ID, filters, embeds, speedrun.WithLimit(maxRecords))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Leaderboard
+	for _, lb := range lbs {
+		res = append(res, &Leaderboard{*lb, g.client, []speedrun.FetchOption{filters}})
+	}
+	return res, nil
+}
+
 func (g *Game) Runs(ctx context.Context, args FetchRunsArgs) (*RunConnection, error) {
 	if args.Filter != nil && args.Filter.Game != nil {
 		return nil, errors.New("cannot filter runs by game when reading from a specific game")
@@ -390,6 +503,10 @@ func (gm *GameModerator) User(ctx context.Context) (*User, error) {
 		return nil, err
 	}
 
+	if user == nil {
+		return nil, nil
+	}
+
 	return &User{*user, gm.client}, nil
 }
 
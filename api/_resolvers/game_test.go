@@ -0,0 +1,75 @@
+package resolvers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestGameDerivedGames(t *testing.T) {
+	s := newTestServer(t)
+
+	type gameConnection struct {
+		Nodes []struct {
+			RawID  string
+			Parent *struct{ RawID string }
+		}
+		TotalCount int
+	}
+	var resp struct {
+		Base struct {
+			Parent       *struct{ RawID string }
+			DerivedGames gameConnection
+			RomHacks     gameConnection
+			Fangames     gameConnection
+		}
+		Other struct {
+			DerivedGames gameConnection
+		}
+	}
+	s.query(t, `query($sm64: ID!, $oot: ID!, $rh: ID!, $fan: ID!) {
+		base: game(id: $sm64) {
+			parent { rawID }
+			derivedGames(first: 5, order: {field: NAME_INT}) { nodes { rawID parent { rawID } } totalCount }
+			romHacks: derivedGames(filter: {gameType: $rh}) { nodes { rawID } }
+			fangames: derivedGames(filter: {gameType: $fan}) { nodes { rawID } }
+		}
+		other: game(id: $oot) {
+			derivedGames { nodes { rawID } totalCount }
+		}
+	}`, map[string]interface{}{
+		"sm64": relay.MarshalID("game", "sm64"),
+		"oot":  relay.MarshalID("game", "oot"),
+		"rh":   relay.MarshalID("gametype", "rh"),
+		"fan":  relay.MarshalID("gametype", "fan"),
+	}, &resp)
+
+	ids := func(c gameConnection) []string {
+		var ids []string
+		for _, g := range c.Nodes {
+			ids = append(ids, g.RawID)
+		}
+		return ids
+	}
+
+	base := resp.Base
+	if base.Parent != nil {
+		t.Errorf("sm64 parent = %v, want null", base.Parent)
+	}
+	if got, want := ids(base.DerivedGames), []string{"sr"}; !reflect.DeepEqual(got, want) || base.DerivedGames.TotalCount != 1 {
+		t.Fatalf("derived games = %v (%d total), want %v", got, base.DerivedGames.TotalCount, want)
+	}
+	if parent := base.DerivedGames.Nodes[0].Parent; parent == nil || parent.RawID != "sm64" {
+		t.Errorf("sr parent = %v, want sm64", parent)
+	}
+	if got, want := ids(base.RomHacks), []string{"sr"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("derived rom hacks = %v, want %v", got, want)
+	}
+	if got := ids(base.Fangames); len(got) != 0 {
+		t.Errorf("derived fangames = %v, want none", got)
+	}
+	if got := ids(resp.Other.DerivedGames); len(got) != 0 || resp.Other.DerivedGames.TotalCount != 0 {
+		t.Errorf("oot derived games = %v, want none", got)
+	}
+}
@@ -0,0 +1,123 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (v *Viewer) GameTypes(ctx context.Context, args struct {
+	Order *struct {
+		Field     *GameTypeOrderField
+		Direction *speedrun.OrderDirection
+	}
+	PageArgs
+}) (*GameTypeConnection, error) {
+	var opts []speedrun.FetchOption
+	if args.Order != nil {
+		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
+	}
+
+	var gameTypes []*speedrun.GameType
+	page, err := args.fetch(ctx, "gametype", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		gameTypes, pi, err = v.client.ListGameTypes(ctx, opts...)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GameTypeConnection{
+		client:    v.client,
+		gameTypes: gameTypes,
+		page:      page,
+	}, nil
+}
+
+type GameTypeOrderField string
+
+func (GameTypeOrderField) ImplementsGraphQLType(name string) bool {
+	return name == "GameTypeOrderField"
+}
+
+func (v *GameTypeOrderField) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("GameTypeOrderField value was not a string")
+	}
+
+	*v = GameTypeOrderField(strings.ToLower(s))
+	return nil
+}
+
+type GameTypeConnection struct {
+	client    *speedrun.Client
+	gameTypes []*speedrun.GameType
+	page      *listPage
+}
+
+func (tc *GameTypeConnection) Edges() []*GameTypeEdge {
+	var edges []*GameTypeEdge
+	for i, gt := range tc.gameTypes {
+		edges = append(edges, &GameTypeEdge{
+			Node:   &GameType{*gt, tc.client},
+			cursor: tc.page.cursor(i),
+		})
+	}
+	return edges
+}
+
+func (tc *GameTypeConnection) Nodes() []*GameType {
+	var nodes []*GameType
+	for _, gt := range tc.gameTypes {
+		nodes = append(nodes, &GameType{*gt, tc.client})
+	}
+	return nodes
+}
+
+func (tc *GameTypeConnection) PageInfo() *PageInfo {
+	return tc.page.pageInfo()
+}
+
+func (tc *GameTypeConnection) TotalCount() (*int32, error) {
+	return tc.page.totalCount()
+}
+
+func (tc *GameTypeConnection) PageSize() int32 {
+	return tc.page.pageSize()
+}
+
+type GameTypeEdge struct {
+	Node   *GameType
+	cursor Cursor
+}
+
+func (e *GameTypeEdge) Cursor() Cursor {
+	return e.cursor
+}
+
+type GameType struct {
+	speedrun.GameType
+	client *speedrun.Client
+}
+
+func (t *GameType) ID() graphql.ID {
+	return relay.MarshalID("gametype", t.GameType.ID)
+}
+
+func (t *GameType) RawID() string {
+	return t.GameType.ID
+}
+
+func (t *GameType) Games(ctx context.Context, args FetchGamesArgs) (*GameConnection, error) {
+	if args.Filter != nil && args.Filter.GameType != nil {
+		return nil, errors.New("cannot filter games by game type when reading from a specific game type")
+	}
+
+	return fetchGameConnection(ctx, t.client, args, speedrun.WithFilter("gametype", t.GameType.ID))
+}
@@ -0,0 +1,140 @@
+package resolvers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestGameTypesDevelopersPublishersAndSeries(t *testing.T) {
+	s := newTestServer(t)
+
+	type named struct{ RawID, Name string }
+	type games struct {
+		Nodes []struct{ RawID string }
+	}
+	var resp struct {
+		Viewer struct {
+			GameTypes struct {
+				Nodes      []named
+				TotalCount int
+			}
+			Developers struct{ Nodes []named }
+			Publishers struct{ Nodes []named }
+			Series     struct{ Nodes []named }
+		}
+		Game struct {
+			GameTypes  []named
+			Developers []named
+			Publishers []named
+		}
+		RomHack struct {
+			GameTypes []named
+		}
+		GameType struct {
+			Name           string
+			AllowsBaseGame bool
+			Games          games
+		}
+		Developer struct {
+			Name  string
+			Games games
+		}
+		Publisher struct {
+			Name  string
+			Games games
+		}
+		Series struct{ Name string }
+	}
+	s.query(t, `query($sm64: ID!, $sr: ID!, $rh: ID!, $ead: ID!, $nintendo: ID!, $zelda: ID!) {
+		viewer {
+			gameTypes(order: {field: NAME}) { nodes { rawID name } totalCount }
+			developers(order: {field: NAME, direction: DESC}) { nodes { rawID name } }
+			publishers { nodes { rawID name } }
+			series(filter: {name: "mario"}) { nodes { rawID name } }
+		}
+		game(id: $sm64) {
+			gameTypes { rawID name }
+			developers { rawID name }
+			publishers { rawID name }
+		}
+		romHack: game(id: $sr) {
+			gameTypes { rawID name }
+		}
+		gameType: node(id: $rh) {
+			... on GameType { name allowsBaseGame games { nodes { rawID } } }
+		}
+		developer: node(id: $ead) {
+			... on Developer { name games(order: {field: NAME_INT}) { nodes { rawID } } }
+		}
+		publisher: node(id: $nintendo) {
+			... on Publisher { name games(filter: {name: "ocarina"}) { nodes { rawID } } }
+		}
+		series: node(id: $zelda) {
+			... on Series { name }
+		}
+	}`, map[string]interface{}{
+		"sm64":     relay.MarshalID("game", "sm64"),
+		"sr":       relay.MarshalID("game", "sr"),
+		"rh":       relay.MarshalID("gametype", "rh"),
+		"ead":      relay.MarshalID("developer", "ead"),
+		"nintendo": relay.MarshalID("publisher", "nintendo"),
+		"zelda":    relay.MarshalID("series", "zelda"),
+	}, &resp)
+
+	v := resp.Viewer
+	if want := []named{{"fan", "Fangame"}, {"rh", "ROM Hack"}}; !reflect.DeepEqual(v.GameTypes.Nodes, want) || v.GameTypes.TotalCount != 2 {
+		t.Errorf("game types = %v (%d total), want %v", v.GameTypes.Nodes, v.GameTypes.TotalCount, want)
+	}
+	if want := []named{{"skelux", "Skelux"}, {"ead", "Nintendo EAD"}}; !reflect.DeepEqual(v.Developers.Nodes, want) {
+		t.Errorf("developers = %v, want %v", v.Developers.Nodes, want)
+	}
+	if want := []named{{"nintendo", "Nintendo"}}; !reflect.DeepEqual(v.Publishers.Nodes, want) {
+		t.Errorf("publishers = %v, want %v", v.Publishers.Nodes, want)
+	}
+	if want := []named{{"mario", "Super Mario"}}; !reflect.DeepEqual(v.Series.Nodes, want) {
+		t.Errorf("series = %v, want %v", v.Series.Nodes, want)
+	}
+
+	g := resp.Game
+	if len(g.GameTypes) != 0 {
+		t.Errorf("sm64 game types = %v, want none", g.GameTypes)
+	}
+	if want := []named{{"ead", "Nintendo EAD"}}; !reflect.DeepEqual(g.Developers, want) {
+		t.Errorf("sm64 developers = %v, want %v", g.Developers, want)
+	}
+	if want := []named{{"nintendo", "Nintendo"}}; !reflect.DeepEqual(g.Publishers, want) {
+		t.Errorf("sm64 publishers = %v, want %v", g.Publishers, want)
+	}
+	if want := []named{{"rh", "ROM Hack"}}; !reflect.DeepEqual(resp.RomHack.GameTypes, want) {
+		t.Errorf("sr game types = %v, want %v", resp.RomHack.GameTypes, want)
+	}
+
+	gameIDs := func(gs games) []string {
+		var ids []string
+		for _, g := range gs.Nodes {
+			ids = append(ids, g.RawID)
+		}
+		return ids
+	}
+	if gt := resp.GameType; gt.Name != "ROM Hack" || !gt.AllowsBaseGame || !reflect.DeepEqual(gameIDs(gt.Games), []string{"sr"}) {
+		t.Errorf("game type = %+v, want ROM Hack with sr", gt)
+	}
+	if dev := resp.Developer; dev.Name != "Nintendo EAD" || !reflect.DeepEqual(gameIDs(dev.Games), []string{"oot", "sm64"}) {
+		t.Errorf("developer = %+v, want Nintendo EAD with oot and sm64", dev)
+	}
+	if pub := resp.Publisher; pub.Name != "Nintendo" || !reflect.DeepEqual(gameIDs(pub.Games), []string{"oot"}) {
+		t.Errorf("publisher = %+v, want Nintendo with oot", pub)
+	}
+	if resp.Series.Name != "The Legend of Zelda" {
+		t.Errorf("series name = %q, want The Legend of Zelda", resp.Series.Name)
+	}
+
+	res, _ := s.exec(t, `query($rh: ID!) {
+		node(id: $rh) { ... on GameType { games(filter: {gameType: $rh}) { nodes { rawID } } } }
+	}`, map[string]interface{}{"rh": relay.MarshalID("gametype", "rh")})
+	if len(res.Errors) != 1 {
+		t.Errorf("filtering a game type's games by game type: errors = %+v, want 1", res.Errors)
+	}
+}
@@ -16,33 +16,26 @@ func (v *Viewer) Genres(ctx context.Context, args struct {
 		Field     *GenreOrderField
 		Direction *speedrun.OrderDirection
 	}
-	First *int32
-	After *Cursor
+	PageArgs
 }) (*GenreConnection, error) {
 	var opts []speedrun.FetchOption
 	if args.Order != nil {
 		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
 	}
-	if args.First != nil {
-		opts = append(opts, speedrun.WithLimit(int(*args.First)))
-	}
-	if args.After != nil {
-		offset, err := args.After.GetOffset()
-		if err != nil {
-			return nil, err
-		}
-		opts = append(opts, speedrun.WithOffset(offset))
-	}
 
-	genres, pageInfo, err := v.client.ListGenres(ctx, opts...)
+	var genres []*speedrun.Genre
+	page, err := args.fetch(ctx, "genre", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		genres, pi, err = v.client.ListGenres(ctx, opts...)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &GenreConnection{
-		client:   v.client,
-		genres:   genres,
-		pageInfo: pageInfo,
+		client: v.client,
+		genres: genres,
+		page:   page,
 	}, nil
 }
 
@@ -63,16 +56,17 @@ func (v *GenreOrderField) UnmarshalGraphQL(input interface{}) error {
 }
 
 type GenreConnection struct {
-	client   *speedrun.Client
-	genres   []*speedrun.Genre
-	pageInfo *speedrun.PageInfo
+	client *speedrun.Client
+	genres []*speedrun.Genre
+	page   *listPage
 }
 
 func (gc *GenreConnection) Edges() []*GenreEdge {
 	var edges []*GenreEdge
-	for _, g := range gc.genres {
+	for i, g := range gc.genres {
 		edges = append(edges, &GenreEdge{
-			Node: &Genre{*g, gc.client},
+			Node:   &Genre{*g, gc.client},
+			cursor: gc.page.cursor(i),
 		})
 	}
 	return edges
@@ -87,15 +81,24 @@ func (gc *GenreConnection) Nodes() []*Genre {
 }
 
 func (gc *GenreConnection) PageInfo() *PageInfo {
-	return &PageInfo{gc.pageInfo}
+	return gc.page.pageInfo()
+}
+
+func (gc *GenreConnection) TotalCount() (*int32, error) {
+	return gc.page.totalCount()
+}
+
+func (gc *GenreConnection) PageSize() int32 {
+	return gc.page.pageSize()
 }
 
 type GenreEdge struct {
-	Node *Genre
+	Node   *Genre
+	cursor Cursor
 }
 
-func (GenreEdge) Cursor() *Cursor {
-	return nil
+func (e *GenreEdge) Cursor() Cursor {
+	return e.cursor
 }
 
 type Genre struct {
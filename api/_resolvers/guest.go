@@ -0,0 +1,42 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (v *Viewer) Guest(ctx context.Context, args struct {
+	Name string
+}) (*Guest, error) {
+	guest, err := v.client.GetGuest(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if guest == nil {
+		return nil, nil
+	}
+	return &Guest{*guest, v.client}, nil
+}
+
+type Guest struct {
+	speedrun.Guest
+	client *speedrun.Client
+}
+
+func (g *Guest) ID() graphql.ID {
+	return relay.MarshalID("guest", g.Guest.Name)
+}
+
+func (g *Guest) Runs(ctx context.Context, args FetchRunsArgs) (*RunConnection, error) {
+	if args.Filter != nil && args.Filter.Guest != nil {
+		return nil, errors.New("cannot filter runs by guest when reading from a specific guest")
+	}
+
+	return fetchRunConnection(ctx, g.client, args, speedrun.WithFilter("guest", g.Guest.Name))
+}
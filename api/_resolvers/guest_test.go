@@ -0,0 +1,72 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestGuest(t *testing.T) {
+	s := newTestServer(t)
+
+	type guest struct {
+		ID   graphql.ID
+		Name string
+		Runs struct {
+			Nodes []struct{ RawID string }
+		}
+	}
+	var resp struct {
+		Viewer struct {
+			Guest   *guest
+			Missing *guest
+		}
+		Run struct {
+			Players []struct {
+				Name  string
+				Guest *guest
+			}
+		}
+	}
+	s.query(t, `query($run: ID!) {
+		viewer {
+			guest(name: "guesty") { id name runs { nodes { rawID } } }
+			missing: guest(name: "nobody") { name }
+		}
+		run: node(id: $run) {
+			... on Run {
+				players { ... on GuestRunPlayer { name guest { id name } } }
+			}
+		}
+	}`, map[string]interface{}{"run": relay.MarshalID("run", "g1")}, &resp)
+
+	g := resp.Viewer.Guest
+	if g == nil || g.Name != "Guesty" || g.ID != relay.MarshalID("guest", "Guesty") {
+		t.Fatalf("guest = %+v, want Guesty", g)
+	}
+	if len(g.Runs.Nodes) != 1 || g.Runs.Nodes[0].RawID != "g1" {
+		t.Errorf("guest runs = %v, want g1", g.Runs.Nodes)
+	}
+	if resp.Viewer.Missing != nil {
+		t.Errorf("missing guest = %+v, want null", resp.Viewer.Missing)
+	}
+
+	players := resp.Run.Players
+	if len(players) != 1 || players[0].Name != "Guesty" || players[0].Guest == nil || players[0].Guest.ID != g.ID {
+		t.Errorf("run players = %+v, want Guesty", players)
+	}
+
+	var node struct {
+		Node struct{ Name string }
+	}
+	s.query(t, `query($id: ID!) { node(id: $id) { ... on Guest { name } } }`, map[string]interface{}{"id": g.ID}, &node)
+	if node.Node.Name != "Guesty" {
+		t.Errorf("node(%s) = %+v, want Guesty", g.ID, node.Node)
+	}
+
+	res, _ := s.exec(t, `{ viewer { guest(name: "guesty") { runs(filter: {guest: "other"}) { nodes { id } } } } }`, nil)
+	if len(res.Errors) != 1 {
+		t.Errorf("filtering a guest's runs by guest: errors = %+v, want 1", res.Errors)
+	}
+}
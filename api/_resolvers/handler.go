@@ -0,0 +1,74 @@
+package resolvers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/mjm/graphql-go"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+// Handler returns an HTTP handler that executes GraphQL requests against schema.
+//
+// Each request loads speedrun.com data through its own loader, rather than
+// sharing a cache with other requests, and errors from speedrun.com are
+// reported with a code in their extensions. Requests with a speedrun.com API
+// key in their Authorization header are made to speedrun.com as that user.
+func (r *Resolvers) Handler(schema *graphql.Schema) http.Handler {
+	return &handler{
+		schema:    schema,
+		resolvers: r,
+	}
+}
+
+type handler struct {
+	schema    *graphql.Schema
+	resolvers *Resolvers
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := h.resolvers.client.WithLoader(r.Context())
+	ctx = withMaxCountRequests(ctx, h.resolvers.MaxCountRequests)
+	if key := apiKey(r); key != "" {
+		ctx = speedrun.WithAPIKey(ctx, key)
+	}
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	for _, err := range response.Errors {
+		if err.Extensions == nil {
+			err.Extensions = errorExtensions(err.ResolverError)
+		}
+	}
+
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(responseJSON)
+}
+
+// apiKey finds the speedrun.com API key a request was made with, either as a
+// bearer token or in an X-API-Key header like speedrun.com itself expects.
+func apiKey(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		const prefix = "Bearer "
+		if len(auth) > len(prefix) && strings.EqualFold(auth[:len(prefix)], prefix) {
+			return strings.TrimSpace(auth[len(prefix):])
+		}
+	}
+	return r.Header.Get("X-API-Key")
+}
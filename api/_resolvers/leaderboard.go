@@ -2,6 +2,10 @@ package resolvers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/mjm/graphql-go"
 	"github.com/mjm/graphql-go/relay"
@@ -17,6 +21,14 @@ func (v *Viewer) Leaderboard(ctx context.Context, args struct {
 		ID    graphql.ID
 		Value graphql.ID
 	}
+	Top       *int32       `filter:"top"`
+	Platform  *graphql.ID  `filter:"platform"`
+	Region    *graphql.ID  `filter:"region"`
+	Emulators *bool        `filter:"emulators"`
+	VideoOnly *bool        `filter:"video-only"`
+	Timing    *GameRunTime `filter:"timing"`
+	Date      *string      `filter:"date"`
+	Embed     *[]RunEmbed
 }) (*Leaderboard, error) {
 	var gameID string
 	if err := relay.UnmarshalSpec(args.Game, &gameID); err != nil {
@@ -34,7 +46,7 @@ func (v *Viewer) Leaderboard(ctx context.Context, args struct {
 		}
 	}
 
-	var opts []speedrun.FetchOption
+	opts := []speedrun.FetchOption{speedrun.WithFilters(args), withEmbeds(args.Embed, planLeaderboardEmbeds(selectionFromContext(ctx)))}
 	if args.Variables != nil {
 		for _, v := range *args.Variables {
 			var varID string
@@ -55,12 +67,54 @@ func (v *Viewer) Leaderboard(ctx context.Context, args struct {
 		return nil, nil
 	}
 
-	return &Leaderboard{*lb, v.client}, nil
+	return &Leaderboard{*lb, v.client, opts}, nil
+}
+
+type RecordsArgs struct {
+	Top           *int32        `filter:"top"`
+	Scope         *RecordsScope `filter:"scope"`
+	Miscellaneous *bool         `filter:"miscellaneous"`
+	SkipEmpty     *bool         `filter:"skip-empty"`
+	Embed         *[]RunEmbed
+}
+
+// maxRecords is the most leaderboards speedrun.com will return from a records
+// request, which is enough to cover the categories and levels of nearly any game.
+const maxRecords = 200
+
+type RecordsScope string
+
+func (RecordsScope) ImplementsGraphQLType(name string) bool {
+	return name == "RecordsScope"
+}
+
+func (v *RecordsScope) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("RecordsScope value was not a string")
+	}
+
+	switch s {
+	case "FULL_GAME":
+		*v = "full-game"
+	case "LEVELS":
+		*v = "levels"
+	case "ALL":
+		*v = "all"
+	default:
+		return fmt.Errorf("unknown RecordsScope value %q", s)
+	}
+
+	return nil
 }
 
 type Leaderboard struct {
 	speedrun.Leaderboard
 	client *speedrun.Client
+
+	// filters are the options the leaderboard was fetched with, which decide
+	// which runs it has.
+	filters []speedrun.FetchOption
 }
 
 func (l *Leaderboard) Game(ctx context.Context) (*Game, error) {
@@ -110,19 +164,240 @@ func (l *Leaderboard) Timing() GameRunTime {
 	return GameRunTime(l.Leaderboard.Timing)
 }
 
-func (l *Leaderboard) Runs(args struct {
-	First int32
-}) []*PlacedRun {
-	max := int32(len(l.Leaderboard.Runs))
-	if max > args.First {
-		max = args.First
+const (
+	// defaultLeaderboardPageSize is how many runs are returned from a
+	// leaderboard when neither first nor last is given.
+	defaultLeaderboardPageSize = 3
+
+	// maxLeaderboardPageSize is the most runs that can be returned from a
+	// leaderboard at once.
+	maxLeaderboardPageSize = 200
+)
+
+func (l *Leaderboard) Runs(ctx context.Context, args struct {
+	Country *string
+	PageArgs
+}) (*PlacedRunConnection, error) {
+	runs := l.Leaderboard.Runs
+	if args.Country != nil {
+		var err error
+		if runs, err = l.runsInCountry(ctx, *args.Country); err != nil {
+			return nil, err
+		}
+	}
+
+	fingerprint, err := l.runsFingerprint(args.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.First == nil && args.Last == nil {
+		size := int32(defaultLeaderboardPageSize)
+		if args.Before != nil && args.After == nil {
+			args.Last = &size
+		} else {
+			args.First = &size
+		}
+	}
+
+	start, end := 0, len(runs)
+	if args.After != nil {
+		i, found, err := placeIndex(runs, *args.After, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		start = i
+		if found {
+			start++
+		}
+	}
+	if args.Before != nil {
+		i, _, err := placeIndex(runs, *args.Before, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		end = i
+	}
+
+	if args.First != nil {
+		if *args.First < 0 {
+			return nil, errors.New("first cannot be negative")
+		}
+		if *args.First > maxLeaderboardPageSize {
+			return nil, fmt.Errorf("first cannot be more than %d", maxLeaderboardPageSize)
+		}
+		if start+int(*args.First) < end {
+			end = start + int(*args.First)
+		}
+	}
+	if args.Last != nil {
+		if *args.Last < 0 {
+			return nil, errors.New("last cannot be negative")
+		}
+		if *args.Last > maxLeaderboardPageSize {
+			return nil, fmt.Errorf("last cannot be more than %d", maxLeaderboardPageSize)
+		}
+		if end-int(*args.Last) > start {
+			start = end - int(*args.Last)
+		}
 	}
+	if end < start {
+		end = start
+	}
+
+	return &PlacedRunConnection{
+		client:      l.client,
+		runs:        runs,
+		fingerprint: fingerprint,
+		start:       start,
+		end:         end,
+	}, nil
+}
 
-	var runs []*PlacedRun
-	for _, r := range l.Leaderboard.Runs[:max] {
-		runs = append(runs, &PlacedRun{r, l.client})
+// runsFingerprint identifies the list of runs on the leaderboard, filtered to
+// country if it's set, so that cursors for one list can't be used with another.
+// Leaderboards for the same category with different filters have different
+// fingerprints.
+func (l *Leaderboard) runsFingerprint(country *string) (string, error) {
+	opts := append([]speedrun.FetchOption(nil), l.filters...)
+	if country != nil {
+		opts = append(opts, speedrun.WithFilter("country", strings.ToLower(*country)))
 	}
-	return runs
+
+	kind := fmt.Sprintf("leaderboard/%s/%s/%s", l.GameID, l.CategoryID, l.LevelID)
+	return listFingerprint(kind, opts)
+}
+
+// runsInCountry filters the leaderboard down to runs with a player from
+// country. Runs keep their places on the full leaderboard.
+//
+// Players' locations come from the users embedded in the leaderboard when
+// they're there. Otherwise the players are loaded together in one batch.
+func (l *Leaderboard) runsInCountry(ctx context.Context, country string) ([]speedrun.PlacedRun, error) {
+	users, err := l.Leaderboard.Players()
+	if err != nil {
+		return nil, err
+	}
+	if users == nil {
+		var userIDs []string
+		for _, pr := range l.Leaderboard.Runs {
+			for _, p := range pr.Run.Players {
+				if p.Rel == speedrun.PlayerUser {
+					userIDs = append(userIDs, p.ID)
+				}
+			}
+		}
+
+		if users, err = l.client.GetUsers(ctx, userIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	var runs []speedrun.PlacedRun
+	for _, pr := range l.Leaderboard.Runs {
+		for _, p := range pr.Run.Players {
+			if u := users[p.ID]; p.Rel == speedrun.PlayerUser && u != nil && inCountry(u, country) {
+				runs = append(runs, pr)
+				break
+			}
+		}
+	}
+	return runs, nil
+}
+
+// placeIndex finds the index of the run that the cursor c was created for.
+// If the leaderboard has changed so that the run is no longer there, it returns
+// the index of the first run placed after it, and found is false.
+func placeIndex(runs []speedrun.PlacedRun, c Cursor, fingerprint string) (i int, found bool, err error) {
+	place, tie, err := c.GetPlace(fingerprint)
+	if err != nil {
+		return 0, false, err
+	}
+
+	i = sort.Search(len(runs), func(i int) bool {
+		return runs[i].Place >= place
+	})
+	if i+tie < len(runs) && runs[i+tie].Place == place {
+		return i + tie, true, nil
+	}
+
+	i = sort.Search(len(runs), func(i int) bool {
+		return runs[i].Place > place
+	})
+	return i, false, nil
+}
+
+type PlacedRunConnection struct {
+	client      *speedrun.Client
+	runs        []speedrun.PlacedRun
+	fingerprint string
+	start       int
+	end         int
+}
+
+func (prc *PlacedRunConnection) Edges() []*PlacedRunEdge {
+	var edges []*PlacedRunEdge
+	for i := prc.start; i < prc.end; i++ {
+		edges = append(edges, &PlacedRunEdge{
+			Node:   &PlacedRun{prc.runs[i], prc.client},
+			cursor: prc.cursor(i),
+		})
+	}
+	return edges
+}
+
+func (prc *PlacedRunConnection) Nodes() []*PlacedRun {
+	var nodes []*PlacedRun
+	for _, r := range prc.runs[prc.start:prc.end] {
+		nodes = append(nodes, &PlacedRun{r, prc.client})
+	}
+	return nodes
+}
+
+func (prc *PlacedRunConnection) PageInfo() *PageInfo {
+	pi := &PageInfo{
+		hasNextPage:     prc.end < len(prc.runs),
+		hasPreviousPage: prc.start > 0,
+	}
+
+	if prc.end > prc.start {
+		start, end := prc.cursor(prc.start), prc.cursor(prc.end-1)
+		pi.startCursor = &start
+		pi.endCursor = &end
+	}
+
+	return pi
+}
+
+func (prc *PlacedRunConnection) TotalCount() *int32 {
+	count := int32(len(prc.runs))
+	return &count
+}
+
+func (prc *PlacedRunConnection) PageSize() int32 {
+	return int32(prc.end - prc.start)
+}
+
+// cursor creates a cursor for the run at index i, which is keyed by its place
+// so that it stays valid as the leaderboard changes.
+func (prc *PlacedRunConnection) cursor(i int) Cursor {
+	place := prc.runs[i].Place
+
+	tie := 0
+	for tie < i && prc.runs[i-tie-1].Place == place {
+		tie++
+	}
+
+	return newPlaceCursor(place, tie, prc.fingerprint)
+}
+
+type PlacedRunEdge struct {
+	Node   *PlacedRun
+	cursor Cursor
+}
+
+func (e *PlacedRunEdge) Cursor() Cursor {
+	return e.cursor
 }
 
 type PlacedRun struct {
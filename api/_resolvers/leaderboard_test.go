@@ -0,0 +1,264 @@
+package resolvers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+	"github.com/mjm/graphql-go/trace"
+)
+
+type placedRunsResponse struct {
+	Viewer struct {
+		Leaderboard struct {
+			Runs struct {
+				Edges []struct {
+					Cursor string
+					Node   struct {
+						Place int
+						Run   struct{ RawID string }
+					}
+				}
+				PageInfo struct{ HasNextPage, HasPreviousPage bool }
+			}
+		}
+	}
+}
+
+func (r *placedRunsResponse) runIDs() []string {
+	var ids []string
+	for _, e := range r.Viewer.Leaderboard.Runs.Edges {
+		ids = append(ids, e.Node.Run.RawID)
+	}
+	return ids
+}
+
+func (r *placedRunsResponse) endCursor() string {
+	edges := r.Viewer.Leaderboard.Runs.Edges
+	return edges[len(edges)-1].Cursor
+}
+
+const leaderboardRunsQuery = `query($game: ID!, $category: ID!, $country: String, $first: Int, $after: Cursor, $last: Int, $before: Cursor) {
+	viewer {
+		leaderboard(game: $game, category: $category) {
+			runs(country: $country, first: $first, after: $after, last: $last, before: $before) {
+				edges { cursor node { place run { rawID } } }
+				pageInfo { hasNextPage hasPreviousPage }
+			}
+		}
+	}
+}`
+
+func leaderboardVars(vars map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"game":     relay.MarshalID("game", "sm64"),
+		"category": relay.MarshalID("category", "120"),
+	}
+	for k, v := range vars {
+		res[k] = v
+	}
+	return res
+}
+
+func TestLeaderboardRunsPaging(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(nil), &resp)
+	if got, want := resp.runIDs(), []string{"r1", "r2", "r3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("default page = %v, want %v", got, want)
+	}
+	if pi := resp.Viewer.Leaderboard.Runs.PageInfo; !pi.HasNextPage || pi.HasPreviousPage {
+		t.Errorf("default page info = %+v, want only a next page", pi)
+	}
+
+	// r2 and r3 tie for second, so the cursor needs to pick out r3.
+	var next placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"first": 2, "after": resp.endCursor()}), &next)
+	if got, want := next.runIDs(), []string{"r4", "r5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("next page = %v, want %v", got, want)
+	}
+
+	var prev placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"last": 2, "before": next.Viewer.Leaderboard.Runs.Edges[0].Cursor}), &prev)
+	if got, want := prev.runIDs(), []string{"r2", "r3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("previous page = %v, want %v", got, want)
+	}
+
+	// Without first or last, a page before a cursor ends at the cursor.
+	var before placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"first": 8}), &before)
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"before": before.endCursor()}), &before)
+	if got, want := before.runIDs(), []string{"r5", "r6", "r7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("page before r8 = %v, want %v", got, want)
+	}
+
+	var last placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"last": 2}), &last)
+	if got, want := last.runIDs(), []string{"r7", "r8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("last page = %v, want %v", got, want)
+	}
+}
+
+func TestLeaderboardRunsMaxPageSize(t *testing.T) {
+	s := newTestServer(t)
+
+	for _, arg := range []string{"first", "last"} {
+		res, _ := s.exec(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{arg: maxLeaderboardPageSize + 1}))
+		if len(res.Errors) != 1 {
+			t.Errorf("%s: %d errors, want 1", arg, len(res.Errors))
+		}
+	}
+
+	var resp placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"first": maxLeaderboardPageSize}), &resp)
+	if len(resp.runIDs()) != 8 {
+		t.Errorf("got %d runs, want all 8", len(resp.runIDs()))
+	}
+}
+
+const filteredLeaderboardRunsQuery = `query($game: ID!, $category: ID!, $variables: [VariableFilter!], $top: Int, $platform: ID, $region: ID, $emulators: Boolean, $videoOnly: Boolean, $timing: GameRunTime, $date: String, $country: String, $after: Cursor) {
+	viewer {
+		leaderboard(game: $game, category: $category, variables: $variables, top: $top, platform: $platform, region: $region, emulators: $emulators, videoOnly: $videoOnly, timing: $timing, date: $date) {
+			runs(country: $country, after: $after) {
+				edges { cursor node { place run { rawID } } }
+				pageInfo { hasNextPage hasPreviousPage }
+			}
+		}
+	}
+}`
+
+func TestLeaderboardRunsCursorFilter(t *testing.T) {
+	s := newTestServer(t)
+
+	var all, jp placedRunsResponse
+	s.query(t, leaderboardRunsQuery, leaderboardVars(nil), &all)
+	s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"country": "jp"}), &jp)
+	if got, want := jp.runIDs(), []string{"r1", "r4", "r7"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("runs in jp = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name    string
+		country interface{}
+		after   string
+		wantErr bool
+	}{
+		{"unfiltered cursor", nil, all.endCursor(), false},
+		{"filtered cursor", "jp", jp.endCursor(), false},
+		{"filtered cursor with another case", "JP", jp.endCursor(), false},
+		{"unfiltered cursor with a filter", "jp", all.endCursor(), true},
+		{"filtered cursor without a filter", nil, jp.endCursor(), true},
+		{"filtered cursor with another filter", "us", jp.endCursor(), true},
+	}
+
+	for _, tt := range tests {
+		res, _ := s.exec(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"country": tt.country, "after": tt.after}))
+		if gotErr := len(res.Errors) > 0; gotErr != tt.wantErr {
+			t.Errorf("%s: errors = %+v, want error %v", tt.name, res.Errors, tt.wantErr)
+		}
+	}
+
+	// Cursors are also tied to the filters the leaderboard itself was
+	// fetched with.
+	filters := []map[string]interface{}{
+		{"variables": []map[string]interface{}{{"id": relay.MarshalID("variable", "ver"), "value": "jp"}}},
+		{"top": 5},
+		{"platform": relay.MarshalID("platform", "n64")},
+		{"region": relay.MarshalID("region", "ntsc")},
+		{"emulators": false},
+		{"videoOnly": false},
+		{"timing": "REALTIME"},
+		{"date": "2100-01-01"},
+	}
+
+	for _, filter := range filters {
+		vars := leaderboardVars(filter)
+		vars["after"] = all.endCursor()
+		if res, _ := s.exec(t, filteredLeaderboardRunsQuery, vars); len(res.Errors) == 0 {
+			t.Errorf("%v: unfiltered cursor was accepted", filter)
+		}
+
+		var filtered placedRunsResponse
+		vars["after"] = nil
+		s.query(t, filteredLeaderboardRunsQuery, vars, &filtered)
+		if len(filtered.Viewer.Leaderboard.Runs.Edges) == 0 {
+			continue
+		}
+
+		vars["after"] = filtered.endCursor()
+		if res, _ := s.exec(t, filteredLeaderboardRunsQuery, vars); len(res.Errors) > 0 {
+			t.Errorf("%v: filtered cursor with the same filters: errors = %+v", filter, res.Errors)
+		}
+		if res, _ := s.exec(t, filteredLeaderboardRunsQuery, leaderboardVars(map[string]interface{}{"after": filtered.endCursor()})); len(res.Errors) == 0 {
+			t.Errorf("%v: filtered cursor was accepted without filters", filter)
+		}
+	}
+}
+
+func TestLeaderboardRunsInCountryRequests(t *testing.T) {
+	s := newTestServer(t)
+
+	q := `query($game: ID!, $category: ID!, $country: String) {
+		viewer {
+			leaderboard(game: $game, category: $category) {
+				runs(country: $country, first: 10) { edges { node { place run { rawID } } } }
+			}
+		}
+	}`
+	var resp placedRunsResponse
+	n := s.query(t, q, leaderboardVars(map[string]interface{}{"country": "us"}), &resp)
+	if got, want := resp.runIDs(), []string{"r2", "r5", "r8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("runs in us = %v, want %v", got, want)
+	}
+	if n != 1 {
+		t.Errorf("made %d requests, want 1 for the leaderboard with its players", n)
+	}
+
+	var records struct {
+		Game struct {
+			Records []struct {
+				Category struct{ Name string }
+				Runs     struct {
+					Nodes []struct{ Place int }
+				}
+			}
+		}
+	}
+	n = s.query(t, `query($id: ID!) {
+		game(id: $id) {
+			records(top: 10, skipEmpty: true) {
+				category { name }
+				runs(country: "jp", first: 10) { nodes { place } }
+			}
+		}
+	}`, map[string]interface{}{"id": relay.MarshalID("game", "sm64")}, &records)
+	var places []int
+	for _, lb := range records.Game.Records {
+		if lb.Category.Name == "120 Star" {
+			for _, r := range lb.Runs.Nodes {
+				places = append(places, r.Place)
+			}
+		}
+	}
+	if want := []int{1, 4, 7}; !reflect.DeepEqual(places, want) {
+		t.Errorf("120 Star places in jp = %v, want %v", places, want)
+	}
+	if n != 2 {
+		t.Errorf("made %d requests, want 2 for the game and its records", n)
+	}
+}
+
+func TestLeaderboardRunsInCountryWithoutEmbeds(t *testing.T) {
+	s := newTestServer(t)
+	s.handler = newTestHandler(t, s.Server, trace.NoopTracer{})
+
+	var resp placedRunsResponse
+	n := s.query(t, leaderboardRunsQuery, leaderboardVars(map[string]interface{}{"country": "us"}), &resp)
+	if got, want := resp.runIDs(), []string{"r2", "r5", "r8"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("runs in us = %v, want %v", got, want)
+	}
+	if n != 9 {
+		t.Errorf("made %d requests, want 9 for the leaderboard and each of its players", n)
+	}
+}
@@ -64,3 +64,18 @@ func (l *Level) Variables(ctx context.Context) ([]*Variable, error) {
 	}
 	return res, nil
 }
+
+func (l *Level) Records(ctx context.Context, args RecordsArgs) ([]*Leaderboard, error) {
+	filters := speedrun.WithFilters(args)
+	embeds := withEmbeds(args.Embed, planLeaderboardEmbeds(selectionFromContext(ctx)))
+	lbs, _, err := l.client.ListLevelRecords(ctx, l.Level.ID, filters, embeds, speedrun.WithLimit(maxRecords))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Leaderboard
+	for _, lb := range lbs {
+		res = append(res, &Leaderboard{*lb, l.client, []speedrun.FetchOption{filters}})
+	}
+	return res, nil
+}
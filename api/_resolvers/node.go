@@ -29,6 +29,14 @@ func (r *Resolvers) Node(ctx context.Context, args struct{ ID graphql.ID }) (*No
 		if cat != nil {
 			n = &Category{*cat, r.client}
 		}
+	case "developer":
+		dev, err := r.client.GetDeveloper(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if dev != nil {
+			n = &Developer{*dev, r.client}
+		}
 	case "engine":
 		eng, err := r.client.GetEngine(ctx, id)
 		if err != nil {
@@ -45,6 +53,14 @@ func (r *Resolvers) Node(ctx context.Context, args struct{ ID graphql.ID }) (*No
 		if game != nil {
 			n = &Game{*game, r.client}
 		}
+	case "gametype":
+		gt, err := r.client.GetGameType(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if gt != nil {
+			n = &GameType{*gt, r.client}
+		}
 	case "genre":
 		genre, err := r.client.GetGenre(ctx, id)
 		if err != nil {
@@ -53,6 +69,14 @@ func (r *Resolvers) Node(ctx context.Context, args struct{ ID graphql.ID }) (*No
 		if genre != nil {
 			n = &Genre{*genre, r.client}
 		}
+	case "guest":
+		guest, err := r.client.GetGuest(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if guest != nil {
+			n = &Guest{*guest, r.client}
+		}
 	case "level":
 		level, err := r.client.GetLevel(ctx, id)
 		if err != nil {
@@ -69,6 +93,14 @@ func (r *Resolvers) Node(ctx context.Context, args struct{ ID graphql.ID }) (*No
 		if plat != nil {
 			n = &Platform{*plat, r.client}
 		}
+	case "publisher":
+		pub, err := r.client.GetPublisher(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if pub != nil {
+			n = &Publisher{*pub, r.client}
+		}
 	case "region":
 		reg, err := r.client.GetRegion(ctx, id)
 		if err != nil {
@@ -85,6 +117,14 @@ func (r *Resolvers) Node(ctx context.Context, args struct{ ID graphql.ID }) (*No
 		if run != nil {
 			n = &Run{*run, r.client}
 		}
+	case "series":
+		series, err := r.client.GetSeries(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if series != nil {
+			n = &Series{*series, r.client}
+		}
 	case "user":
 		user, err := r.client.GetUser(ctx, id)
 		if err != nil {
@@ -119,6 +159,11 @@ func (n *Node) ToCategory() (*Category, bool) {
 	return c, ok
 }
 
+func (n *Node) ToDeveloper() (*Developer, bool) {
+	d, ok := n.nodeResolver.(*Developer)
+	return d, ok
+}
+
 func (n *Node) ToEngine() (*Engine, bool) {
 	e, ok := n.nodeResolver.(*Engine)
 	return e, ok
@@ -129,11 +174,21 @@ func (n *Node) ToGame() (*Game, bool) {
 	return g, ok
 }
 
+func (n *Node) ToGameType() (*GameType, bool) {
+	t, ok := n.nodeResolver.(*GameType)
+	return t, ok
+}
+
 func (n *Node) ToGenre() (*Genre, bool) {
 	g, ok := n.nodeResolver.(*Genre)
 	return g, ok
 }
 
+func (n *Node) ToGuest() (*Guest, bool) {
+	g, ok := n.nodeResolver.(*Guest)
+	return g, ok
+}
+
 func (n *Node) ToLevel() (*Level, bool) {
 	l, ok := n.nodeResolver.(*Level)
 	return l, ok
@@ -144,6 +199,11 @@ func (n *Node) ToPlatform() (*Platform, bool) {
 	return p, ok
 }
 
+func (n *Node) ToPublisher() (*Publisher, bool) {
+	p, ok := n.nodeResolver.(*Publisher)
+	return p, ok
+}
+
 func (n *Node) ToRegion() (*Region, bool) {
 	r, ok := n.nodeResolver.(*Region)
 	return r, ok
@@ -154,6 +214,11 @@ func (n *Node) ToRun() (*Run, bool) {
 	return r, ok
 }
 
+func (n *Node) ToSeries() (*Series, bool) {
+	s, ok := n.nodeResolver.(*Series)
+	return s, ok
+}
+
 func (n *Node) ToUser() (*User, bool) {
 	u, ok := n.nodeResolver.(*User)
 	return u, ok
@@ -0,0 +1,215 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mjm/graphql-go"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (u *User) Notifications(ctx context.Context, args struct {
+	Order *struct {
+		Field     *NotificationOrderField
+		Direction *speedrun.OrderDirection
+	}
+	PageArgs
+}) (*NotificationConnection, error) {
+	// The profile is loaded through the request's loader, so checking it for
+	// each user only asks speedrun.com for it once.
+	me, err := u.client.GetProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if me == nil || me.ID != u.User.ID {
+		return nil, errors.New("notifications can only be read for the authenticated user")
+	}
+
+	var opts []speedrun.FetchOption
+	if args.Order != nil {
+		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
+	}
+
+	var notifications []*speedrun.Notification
+	page, err := args.fetch(ctx, "notification", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		notifications, pi, err = u.client.ListNotifications(ctx, opts...)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationConnection{
+		client:        u.client,
+		notifications: notifications,
+		page:          page,
+	}, nil
+}
+
+type NotificationOrderField string
+
+func (NotificationOrderField) ImplementsGraphQLType(name string) bool {
+	return name == "NotificationOrderField"
+}
+
+func (v *NotificationOrderField) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("NotificationOrderField value was not a string")
+	}
+
+	*v = NotificationOrderField(strings.ToLower(s))
+	return nil
+}
+
+type NotificationConnection struct {
+	client        *speedrun.Client
+	notifications []*speedrun.Notification
+	page          *listPage
+}
+
+func (nc *NotificationConnection) Edges() []*NotificationEdge {
+	var edges []*NotificationEdge
+	for i, n := range nc.notifications {
+		edges = append(edges, &NotificationEdge{
+			Node:   &Notification{*n, nc.client},
+			cursor: nc.page.cursor(i),
+		})
+	}
+	return edges
+}
+
+func (nc *NotificationConnection) Nodes() []*Notification {
+	var nodes []*Notification
+	for _, n := range nc.notifications {
+		nodes = append(nodes, &Notification{*n, nc.client})
+	}
+	return nodes
+}
+
+func (nc *NotificationConnection) PageInfo() *PageInfo {
+	return nc.page.pageInfo()
+}
+
+func (nc *NotificationConnection) TotalCount() (*int32, error) {
+	return nc.page.totalCount()
+}
+
+func (nc *NotificationConnection) PageSize() int32 {
+	return nc.page.pageSize()
+}
+
+type NotificationEdge struct {
+	Node   *Notification
+	cursor Cursor
+}
+
+func (e *NotificationEdge) Cursor() Cursor {
+	return e.cursor
+}
+
+type Notification struct {
+	speedrun.Notification
+	client *speedrun.Client
+}
+
+func (n *Notification) RawID() string {
+	return n.Notification.ID
+}
+
+func (n *Notification) Created() *graphql.Time {
+	if n.Notification.Created == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *n.Notification.Created}
+}
+
+func (n *Notification) Status() NotificationStatus {
+	return NotificationStatus(n.Notification.Status)
+}
+
+func (n *Notification) Item() *NotificationItem {
+	return &NotificationItem{n.Notification.Item, n.Notification.Links, n.client}
+}
+
+type NotificationStatus speedrun.NotificationStatus
+
+func (NotificationStatus) ImplementsGraphQLType(name string) bool {
+	return name == "NotificationStatus"
+}
+
+func (v NotificationStatus) String() string {
+	switch speedrun.NotificationStatus(v) {
+	case speedrun.NotificationRead:
+		return "READ"
+	case speedrun.NotificationUnread:
+		return "UNREAD"
+	default:
+		return ""
+	}
+}
+
+func (v *NotificationStatus) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("NotificationStatus value was not a string")
+	}
+
+	switch s {
+	case "READ":
+		*v = NotificationStatus(speedrun.NotificationRead)
+	case "UNREAD":
+		*v = NotificationStatus(speedrun.NotificationUnread)
+	default:
+		return fmt.Errorf("unknown NotificationStatus value %q", s)
+	}
+
+	return nil
+}
+
+// NotificationItem is the thing a notification is about, like a run that was
+// submitted or a thread that was posted in. Its URI is a page on the
+// speedrun.com website.
+type NotificationItem struct {
+	speedrun.Link
+	links  []speedrun.Link
+	client *speedrun.Client
+}
+
+func (ni *NotificationItem) Rel() string {
+	return ni.Link.Rel
+}
+
+// Node resolves the item to a run or game, when the notification links to one
+// in the API. Items that are neither, like forum posts, have no node.
+func (ni *NotificationItem) Node(ctx context.Context) (*Node, error) {
+	uri := speedrun.FindLink(ni.links, ni.Link.Rel)
+	if uri == "" {
+		return nil, nil
+	}
+
+	switch ni.Link.Rel {
+	case "run":
+		run, err := ni.client.GetRun(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		if run != nil {
+			return &Node{&Run{*run, ni.client}}, nil
+		}
+	case "game":
+		game, err := ni.client.GetGame(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		if game != nil {
+			return &Node{&Game{*game, ni.client}}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,119 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestNotificationItemNode(t *testing.T) {
+	s := newTestServer(t)
+	s.apiKey = "secret"
+
+	var resp struct {
+		Me struct {
+			Notifications struct {
+				Nodes []struct {
+					RawID string
+					Item  struct {
+						Rel  string
+						Node *struct {
+							Typename string `json:"__typename"`
+							RawID    string
+						}
+					}
+				}
+			}
+		}
+	}
+	s.query(t, `{
+		me {
+			notifications(first: 10, order: {field: CREATED}) {
+				nodes {
+					rawID
+					item {
+						rel
+						node {
+							__typename
+							... on Run { rawID }
+							... on Game { rawID }
+						}
+					}
+				}
+			}
+		}
+	}`, nil, &resp)
+
+	want := map[string]string{
+		"n1": "Run r2",
+		"n2": "Game sm64",
+		"n3": "",
+		"n4": "",
+	}
+	nodes := resp.Me.Notifications.Nodes
+	if len(nodes) != len(want) {
+		t.Fatalf("got %d notifications, want %d", len(nodes), len(want))
+	}
+	for _, n := range nodes {
+		var got string
+		if n.Item.Node != nil {
+			got = n.Item.Node.Typename + " " + n.Item.Node.RawID
+		}
+		if got != want[n.RawID] {
+			t.Errorf("%s (%s) has node %q, want %q", n.RawID, n.Item.Rel, got, want[n.RawID])
+		}
+	}
+}
+
+func TestNotificationsLoadProfileOnce(t *testing.T) {
+	s := newTestServer(t)
+	s.apiKey = "secret"
+
+	var resp struct {
+		Me struct {
+			A, B struct{ Nodes []struct{ RawID string } }
+		}
+		Node struct {
+			Notifications struct{ Nodes []struct{ RawID string } }
+		}
+	}
+	n := s.query(t, `query($id: ID!) {
+		me {
+			a: notifications(first: 1) { nodes { rawID } }
+			b: notifications(first: 2) { nodes { rawID } }
+		}
+		node(id: $id) {
+			... on User { notifications(first: 3) { nodes { rawID } } }
+		}
+	}`, map[string]interface{}{"id": relay.MarshalID("user", "u1")}, &resp)
+
+	if len(resp.Me.A.Nodes) != 1 || len(resp.Me.B.Nodes) != 2 || len(resp.Node.Notifications.Nodes) != 3 {
+		t.Errorf("got %d, %d and %d notifications, want 1, 2 and 3", len(resp.Me.A.Nodes), len(resp.Me.B.Nodes), len(resp.Node.Notifications.Nodes))
+	}
+	// The profile, the user, and each list of notifications.
+	if n != 5 {
+		t.Errorf("made %d requests, want 5", n)
+	}
+}
+
+func TestNotificationsOtherUser(t *testing.T) {
+	s := newTestServer(t)
+
+	q := `query($id: ID!) {
+		node(id: $id) {
+			... on User { notifications { nodes { rawID } } }
+		}
+	}`
+	vars := map[string]interface{}{"id": relay.MarshalID("user", "u2")}
+
+	res, _ := s.exec(t, q, vars)
+	if len(res.Errors) != 1 {
+		t.Errorf("without a key: errors = %+v, want 1", res.Errors)
+	}
+
+	s.apiKey = "secret"
+	res, _ = s.exec(t, q, vars)
+	if len(res.Errors) != 1 {
+		t.Errorf("for another user: errors = %+v, want 1", res.Errors)
+	}
+}
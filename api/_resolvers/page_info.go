@@ -1,33 +1,273 @@
 package resolvers
 
 import (
-	"fmt"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"sync"
 
 	"github.com/mjm/speedrungql/speedrun"
 )
 
+// DefaultMaxCountRequests is how many requests may be made to count the items
+// in a list when Resolvers doesn't say otherwise.
+const DefaultMaxCountRequests = 10
+
+type maxCountRequestsKey struct{}
+
+func withMaxCountRequests(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, maxCountRequestsKey{}, n)
+}
+
+func maxCountRequestsFromContext(ctx context.Context) int {
+	if n, ok := ctx.Value(maxCountRequestsKey{}).(int); ok {
+		return n
+	}
+	return DefaultMaxCountRequests
+}
+
+// countPageSize is the largest page speedrun.com will return.
+const countPageSize = 200
+
+// PageArgs are the arguments accepted by every connection field.
+//
+// Cursors hold the offset of an item in the full list, so the arguments
+// can be translated into an offset and limit for speedrun.com.
+type PageArgs struct {
+	First  *int32
+	After  *Cursor
+	Last   *int32
+	Before *Cursor
+}
+
+type listFunc func(opts ...speedrun.FetchOption) (*speedrun.PageInfo, error)
+
+// fetch calls list with options selecting the page described by the args,
+// and returns the resulting page. If the args select no items at all, list
+// isn't called.
+//
+// The kind and opts identify the list being paged through, so that cursors
+// from one list can't be used with another.
+func (args *PageArgs) fetch(ctx context.Context, kind string, opts []speedrun.FetchOption, list listFunc) (*listPage, error) {
+	fingerprint, err := listFingerprint(kind, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &listPage{
+		fingerprint:      fingerprint,
+		opts:             opts,
+		list:             list,
+		maxCountRequests: maxCountRequestsFromContext(ctx),
+	}
+
+	start := 0
+	if args.After != nil {
+		after, err := args.After.GetOffset(fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		start = after + 1
+	}
+
+	end := -1
+	if args.Before != nil {
+		before, err := args.Before.GetOffset(fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		end = before
+	}
+
+	if args.First != nil {
+		if *args.First < 0 {
+			return nil, errors.New("first cannot be negative")
+		}
+		if end < 0 || start+int(*args.First) < end {
+			end = start + int(*args.First)
+		}
+	}
+
+	if args.Last != nil {
+		if *args.Last < 0 {
+			return nil, errors.New("last cannot be negative")
+		}
+		if end < 0 {
+			total, err := page.totalCount()
+			if err != nil {
+				return nil, err
+			}
+			if total == nil {
+				return nil, errors.New("list is too long to paginate from the end without a before cursor")
+			}
+			end = int(*total)
+		}
+		if end-int(*args.Last) > start {
+			start = end - int(*args.Last)
+		}
+	}
+
+	if end >= 0 && end <= start {
+		// An empty window needs no request, and there's no page to tell
+		// whether more items follow it.
+		page.pi = &speedrun.PageInfo{Offset: start}
+		page.empty = true
+		return page, nil
+	}
+
+	var pageOpts []speedrun.FetchOption
+	pageOpts = append(pageOpts, opts...)
+	if start > 0 {
+		pageOpts = append(pageOpts, speedrun.WithOffset(start))
+	}
+	if end >= 0 {
+		pageOpts = append(pageOpts, speedrun.WithLimit(end-start))
+	}
+
+	page.pi, err = list(pageOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func listFingerprint(kind string, opts []speedrun.FetchOption) (string, error) {
+	key, err := speedrun.QueryKey(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum([]byte(kind + "?" + key))
+	return hex.EncodeToString(sum[:4]), nil
+}
+
 type PageInfo struct {
-	pi *speedrun.PageInfo
+	startCursor     *Cursor
+	endCursor       *Cursor
+	hasNextPage     bool
+	hasPreviousPage bool
 }
 
 func (pi *PageInfo) StartCursor() *Cursor {
-	return nil
+	return pi.startCursor
 }
 
 func (pi *PageInfo) EndCursor() *Cursor {
-	if !pi.HasNextPage() {
-		return nil
-	}
-
-	nextCursor := pi.pi.Max + pi.pi.Offset
-	c := Cursor(fmt.Sprintf("%d", nextCursor))
-	return &c
+	return pi.endCursor
 }
 
 func (pi *PageInfo) HasNextPage() bool {
-	return pi.pi.Max == pi.pi.Size
+	return pi.hasNextPage
 }
 
 func (pi *PageInfo) HasPreviousPage() bool {
-	return false
+	return pi.hasPreviousPage
+}
+
+// listPage is a page of a list fetched from speedrun.com, which is paged
+// through by offset.
+type listPage struct {
+	pi          *speedrun.PageInfo
+	fingerprint string
+
+	// empty is set when the args selected no items, so the list wasn't
+	// fetched.
+	empty bool
+
+	opts []speedrun.FetchOption
+	list listFunc
+
+	maxCountRequests int
+	countOnce        sync.Once
+	count            *int32
+	countErr         error
+}
+
+func (lp *listPage) pageInfo() *PageInfo {
+	pi := &PageInfo{
+		hasNextPage:     !lp.empty && lp.pi.Max == lp.pi.Size,
+		hasPreviousPage: lp.pi.Offset > 0,
+	}
+
+	if lp.pi.Size > 0 {
+		start, end := lp.cursor(0), lp.cursor(lp.pi.Size-1)
+		pi.startCursor = &start
+		pi.endCursor = &end
+	}
+
+	return pi
+}
+
+// cursor creates a cursor for the item at index i of the page.
+func (lp *listPage) cursor(i int) Cursor {
+	return newCursor(lp.pi.Offset+i, lp.fingerprint)
+}
+
+func (lp *listPage) pageSize() int32 {
+	return int32(lp.pi.Max)
+}
+
+// totalCount counts the items in the whole list. Since speedrun.com doesn't
+// report this, it's found by probing for pages at different offsets, giving
+// up and returning nil once maxCountRequests have been made.
+func (lp *listPage) totalCount() (*int32, error) {
+	lp.countOnce.Do(func() {
+		lp.count, lp.countErr = lp.countItems()
+	})
+	return lp.count, lp.countErr
+}
+
+func (lp *listPage) countItems() (*int32, error) {
+	found := func(n int) (*int32, error) {
+		count := int32(n)
+		return &count, nil
+	}
+
+	// The total is at least lo, and at most hi once hi is known.
+	lo, hi := 0, -1
+
+	// The page we already have may be enough to know the total.
+	if lp.pi != nil && lp.pi.Size > 0 {
+		lo = lp.pi.Offset + lp.pi.Size
+		if lp.pi.Size < lp.pi.Max {
+			return found(lo)
+		}
+	}
+
+	offset := lo
+	for requests := 0; hi < 0 || lo < hi; requests++ {
+		if requests == lp.maxCountRequests {
+			return nil, nil
+		}
+
+		// Probes only need the size of the page, so embedding anything in
+		// them would be wasted.
+		var opts []speedrun.FetchOption
+		opts = append(opts, lp.opts...)
+		opts = append(opts, speedrun.WithoutEmbeds(), speedrun.WithOffset(offset), speedrun.WithLimit(countPageSize))
+
+		page, err := lp.list(opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case page.Size == 0:
+			hi = offset
+		case page.Size < countPageSize:
+			return found(offset + page.Size)
+		default:
+			lo = offset + page.Size
+		}
+
+		if hi < 0 {
+			offset = 2 * lo
+		} else {
+			offset = (lo + hi) / 2
+		}
+	}
+
+	return found(lo)
 }
@@ -0,0 +1,205 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mjm/speedrungql/speedrun"
+	"github.com/mjm/speedrungql/speedrun/speedruntest"
+)
+
+// newGamesList starts a server with n games and returns a list function that
+// pages through them.
+func newGamesList(t *testing.T, n int) (*speedruntest.Server, listFunc) {
+	t.Helper()
+
+	d := &speedruntest.Dataset{}
+	for i := 0; i < n; i++ {
+		d.Games = append(d.Games, &speedrun.Game{ID: fmt.Sprintf("g%03d", i)})
+	}
+
+	s := speedruntest.NewServer(d)
+	t.Cleanup(s.Close)
+
+	client := s.NewClient()
+	return s, func(opts ...speedrun.FetchOption) (*speedrun.PageInfo, error) {
+		_, pi, err := client.ListGames(context.Background(), opts...)
+		return pi, err
+	}
+}
+
+func TestPageArgsFetch(t *testing.T) {
+	fingerprint, err := listFingerprint("games", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := func(offset int) *Cursor {
+		c := newCursor(offset, fingerprint)
+		return &c
+	}
+	n := func(i int32) *int32 {
+		return &i
+	}
+
+	tests := []struct {
+		name     string
+		args     PageArgs
+		offset   int
+		size     int
+		next     bool
+		prev     bool
+		requests int
+	}{
+		{"no args", PageArgs{}, 0, 10, false, false, 1},
+		{"first", PageArgs{First: n(3)}, 0, 3, true, false, 1},
+		{"first after", PageArgs{First: n(3), After: cursor(2)}, 3, 3, true, true, 1},
+		{"first past the end", PageArgs{First: n(5), After: cursor(7)}, 8, 2, false, true, 1},
+		{"after", PageArgs{After: cursor(7)}, 8, 2, false, true, 1},
+		{"before", PageArgs{Before: cursor(4)}, 0, 4, true, false, 1},
+		{"first before", PageArgs{First: n(2), Before: cursor(4)}, 0, 2, true, false, 1},
+		{"after before", PageArgs{After: cursor(2), Before: cursor(6)}, 3, 3, true, true, 1},
+		{"first after before", PageArgs{First: n(10), After: cursor(2), Before: cursor(6)}, 3, 3, true, true, 1},
+		{"last before", PageArgs{Last: n(2), Before: cursor(5)}, 3, 2, true, true, 1},
+		{"last before past the start", PageArgs{Last: n(20), Before: cursor(3)}, 0, 3, true, false, 1},
+		{"last after before", PageArgs{Last: n(2), After: cursor(1), Before: cursor(3)}, 2, 1, true, true, 1},
+		{"last", PageArgs{Last: n(3)}, 7, 3, true, true, 2},
+		{"first and last", PageArgs{First: n(5), Last: n(2)}, 3, 2, true, true, 1},
+		{"first zero", PageArgs{First: n(0)}, 0, 0, false, false, 0},
+		{"first zero after", PageArgs{First: n(0), After: cursor(4)}, 5, 0, false, true, 0},
+		{"last zero before", PageArgs{Last: n(0), Before: cursor(4)}, 4, 0, false, true, 0},
+		{"before the start", PageArgs{Before: cursor(0)}, 0, 0, false, false, 0},
+		{"before equals after", PageArgs{After: cursor(4), Before: cursor(5)}, 5, 0, false, true, 0},
+		{"before precedes after", PageArgs{After: cursor(6), Before: cursor(3)}, 7, 0, false, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, list := newGamesList(t, 10)
+
+			page, err := tt.args.fetch(context.Background(), "games", nil, list)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if page.pi.Offset != tt.offset || page.pi.Size != tt.size {
+				t.Errorf("fetched %d items at offset %d, want %d at %d", page.pi.Size, page.pi.Offset, tt.size, tt.offset)
+			}
+			pi := page.pageInfo()
+			if pi.hasNextPage != tt.next || pi.hasPreviousPage != tt.prev {
+				t.Errorf("hasNextPage = %v, hasPreviousPage = %v, want %v, %v", pi.hasNextPage, pi.hasPreviousPage, tt.next, tt.prev)
+			}
+			if tt.size > 0 {
+				if want := newCursor(tt.offset, fingerprint); pi.startCursor == nil || *pi.startCursor != want {
+					t.Errorf("startCursor = %v, want %v", pi.startCursor, want)
+				}
+			} else if pi.startCursor != nil || pi.endCursor != nil {
+				t.Errorf("empty page has cursors %v and %v", pi.startCursor, pi.endCursor)
+			}
+			if got := s.Requests(); got != tt.requests {
+				t.Errorf("made %d requests, want %d", got, tt.requests)
+			}
+		})
+	}
+}
+
+func TestPageArgsFetchErrors(t *testing.T) {
+	otherFingerprint, err := listFingerprint("games", []speedrun.FetchOption{speedrun.WithFilter("name", "mario")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCursor := newCursor(2, otherFingerprint)
+	negative := int32(-1)
+
+	tests := []struct {
+		name string
+		args PageArgs
+	}{
+		{"negative first", PageArgs{First: &negative}},
+		{"negative last", PageArgs{Last: &negative}},
+		{"after from another list", PageArgs{After: &otherCursor}},
+		{"before from another list", PageArgs{Before: &otherCursor}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, list := newGamesList(t, 10)
+
+			if _, err := tt.args.fetch(context.Background(), "games", nil, list); err == nil {
+				t.Errorf("fetch() succeeded, want an error")
+			}
+			if got := s.Requests(); got != 0 {
+				t.Errorf("made %d requests, want 0", got)
+			}
+		})
+	}
+}
+
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTotalCount(t *testing.T) {
+	d := &speedruntest.Dataset{}
+	for i := 0; i < 250; i++ {
+		d.Games = append(d.Games, &speedrun.Game{ID: fmt.Sprintf("g%03d", i)})
+	}
+	s := speedruntest.NewServer(d)
+	t.Cleanup(s.Close)
+
+	client := s.NewClient()
+	var embeds []string
+	transport := client.HTTPClient.Transport
+	client.HTTPClient.Transport = transportFunc(func(req *http.Request) (*http.Response, error) {
+		embeds = append(embeds, req.URL.Query().Get("embed"))
+		return transport.RoundTrip(req)
+	})
+	list := func(opts ...speedrun.FetchOption) (*speedrun.PageInfo, error) {
+		_, pi, err := client.ListGames(context.Background(), opts...)
+		return pi, err
+	}
+
+	first := int32(2)
+	args := PageArgs{First: &first}
+	opts := []speedrun.FetchOption{speedrun.WithEmbed(speedrun.EmbedPlatforms)}
+
+	page, err := args.fetch(context.Background(), "games", opts, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := page.totalCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count == nil || *count != 250 {
+		t.Errorf("totalCount() = %v, want 250", count)
+	}
+
+	if len(embeds) < 2 || embeds[0] != "platforms" {
+		t.Fatalf("embeds = %q, want the page to embed platforms", embeds)
+	}
+	for i, embed := range embeds[1:] {
+		if embed != "" {
+			t.Errorf("count request %d embedded %q, want nothing", i, embed)
+		}
+	}
+
+	embeds = nil
+	page, err = args.fetch(withMaxCountRequests(context.Background(), 2), "games", opts, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err = page.totalCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != nil {
+		t.Errorf("totalCount() with 2 requests = %d, want nil", *count)
+	}
+	if len(embeds) != 3 {
+		t.Errorf("made %d requests, want the page and 2 count requests", len(embeds))
+	}
+}
@@ -15,43 +15,37 @@ func (v *Viewer) Platforms(ctx context.Context, args struct {
 		Field     *string
 		Direction *speedrun.OrderDirection
 	}
-	First *int32
-	After *Cursor
+	PageArgs
 }) (*PlatformConnection, error) {
 	var opts []speedrun.FetchOption
 	if args.Order != nil {
 		opts = append(opts, speedrun.WithOrder(args.Order.Field, args.Order.Direction))
 	}
-	if args.First != nil {
-		opts = append(opts, speedrun.WithLimit(int(*args.First)))
-	}
-	if args.After != nil {
-		offset, err := args.After.GetOffset()
-		if err != nil {
-			return nil, err
-		}
-		opts = append(opts, speedrun.WithOffset(offset))
-	}
 
-	plats, pageInfo, err := v.client.ListPlatforms(ctx, opts...)
+	var plats []*speedrun.Platform
+	page, err := args.fetch(ctx, "platform", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		plats, pi, err = v.client.ListPlatforms(ctx, opts...)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &PlatformConnection{v.client, plats, pageInfo}, nil
+	return &PlatformConnection{v.client, plats, page}, nil
 }
 
 type PlatformConnection struct {
 	client    *speedrun.Client
 	platforms []*speedrun.Platform
-	pageInfo  *speedrun.PageInfo
+	page      *listPage
 }
 
 func (pc *PlatformConnection) Edges() []*PlatformEdge {
 	var edges []*PlatformEdge
-	for _, p := range pc.platforms {
+	for i, p := range pc.platforms {
 		edges = append(edges, &PlatformEdge{
-			Node: &Platform{*p, pc.client},
+			Node:   &Platform{*p, pc.client},
+			cursor: pc.page.cursor(i),
 		})
 	}
 	return edges
@@ -66,15 +60,24 @@ func (pc *PlatformConnection) Nodes() []*Platform {
 }
 
 func (pc *PlatformConnection) PageInfo() *PageInfo {
-	return &PageInfo{pc.pageInfo}
+	return pc.page.pageInfo()
+}
+
+func (pc *PlatformConnection) TotalCount() (*int32, error) {
+	return pc.page.totalCount()
+}
+
+func (pc *PlatformConnection) PageSize() int32 {
+	return pc.page.pageSize()
 }
 
 type PlatformEdge struct {
-	Node *Platform
+	Node   *Platform
+	cursor Cursor
 }
 
-func (pe *PlatformEdge) Cursor() *Cursor {
-	return nil
+func (e *PlatformEdge) Cursor() Cursor {
+	return e.cursor
 }
 
 type Platform struct {
@@ -0,0 +1,123 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (v *Viewer) Publishers(ctx context.Context, args struct {
+	Order *struct {
+		Field     *PublisherOrderField
+		Direction *speedrun.OrderDirection
+	}
+	PageArgs
+}) (*PublisherConnection, error) {
+	var opts []speedrun.FetchOption
+	if args.Order != nil {
+		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
+	}
+
+	var publishers []*speedrun.Publisher
+	page, err := args.fetch(ctx, "publisher", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		publishers, pi, err = v.client.ListPublishers(ctx, opts...)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublisherConnection{
+		client:     v.client,
+		publishers: publishers,
+		page:       page,
+	}, nil
+}
+
+type PublisherOrderField string
+
+func (PublisherOrderField) ImplementsGraphQLType(name string) bool {
+	return name == "PublisherOrderField"
+}
+
+func (v *PublisherOrderField) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("PublisherOrderField value was not a string")
+	}
+
+	*v = PublisherOrderField(strings.ToLower(s))
+	return nil
+}
+
+type PublisherConnection struct {
+	client     *speedrun.Client
+	publishers []*speedrun.Publisher
+	page       *listPage
+}
+
+func (pc *PublisherConnection) Edges() []*PublisherEdge {
+	var edges []*PublisherEdge
+	for i, p := range pc.publishers {
+		edges = append(edges, &PublisherEdge{
+			Node:   &Publisher{*p, pc.client},
+			cursor: pc.page.cursor(i),
+		})
+	}
+	return edges
+}
+
+func (pc *PublisherConnection) Nodes() []*Publisher {
+	var nodes []*Publisher
+	for _, p := range pc.publishers {
+		nodes = append(nodes, &Publisher{*p, pc.client})
+	}
+	return nodes
+}
+
+func (pc *PublisherConnection) PageInfo() *PageInfo {
+	return pc.page.pageInfo()
+}
+
+func (pc *PublisherConnection) TotalCount() (*int32, error) {
+	return pc.page.totalCount()
+}
+
+func (pc *PublisherConnection) PageSize() int32 {
+	return pc.page.pageSize()
+}
+
+type PublisherEdge struct {
+	Node   *Publisher
+	cursor Cursor
+}
+
+func (e *PublisherEdge) Cursor() Cursor {
+	return e.cursor
+}
+
+type Publisher struct {
+	speedrun.Publisher
+	client *speedrun.Client
+}
+
+func (p *Publisher) ID() graphql.ID {
+	return relay.MarshalID("publisher", p.Publisher.ID)
+}
+
+func (p *Publisher) RawID() string {
+	return p.Publisher.ID
+}
+
+func (p *Publisher) Games(ctx context.Context, args FetchGamesArgs) (*GameConnection, error) {
+	if args.Filter != nil && args.Filter.Publisher != nil {
+		return nil, errors.New("cannot filter games by publisher when reading from a specific publisher")
+	}
+
+	return fetchGameConnection(ctx, p.client, args, speedrun.WithFilter("publisher", p.Publisher.ID))
+}
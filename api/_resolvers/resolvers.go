@@ -6,11 +6,16 @@ import (
 
 type Resolvers struct {
 	client *speedrun.Client
+
+	// MaxCountRequests limits how many requests to speedrun.com may be made to
+	// count the items in a list, since speedrun.com doesn't report totals.
+	MaxCountRequests int
 }
 
-func New(baseURL string) *Resolvers {
+func New(client *speedrun.Client) *Resolvers {
 	return &Resolvers{
-		client: speedrun.NewClient(baseURL),
+		client:           client,
+		MaxCountRequests: DefaultMaxCountRequests,
 	}
 }
 
@@ -0,0 +1,313 @@
+package resolvers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+	"github.com/mjm/graphql-go/trace"
+
+	"github.com/mjm/speedrungql/speedrun"
+	"github.com/mjm/speedrungql/speedrun/speedruntest"
+)
+
+// testDataset returns the data for a test server. Links to other resources
+// are absolute like speedrun.com's, so they need the server's base URL.
+func testDataset(baseURL string) *speedruntest.Dataset {
+	gameLink := []speedrun.Link{{Rel: "game", URI: baseURL + "/games/sm64"}}
+	marioLink := speedrun.Link{Rel: "series", URI: baseURL + "/series/mario"}
+
+	d := &speedruntest.Dataset{
+		Platforms: []*speedrun.Platform{{ID: "n64", Name: "Nintendo 64"}},
+		Games: []*speedrun.Game{
+			{ID: "sm64", Names: speedrun.GameNames{International: "Super Mario 64"}, Abbreviation: "sm64", Platforms: []string{"n64"}, Developers: []string{"ead"}, Publishers: []string{"nintendo"}, Links: []speedrun.Link{marioLink}},
+			{ID: "oot", Names: speedrun.GameNames{International: "Ocarina of Time"}, Abbreviation: "oot", Platforms: []string{"n64"}, Developers: []string{"ead"}, Publishers: []string{"nintendo"}, Links: []speedrun.Link{{Rel: "series", URI: baseURL + "/series/zelda"}}},
+			{ID: "sr", Names: speedrun.GameNames{International: "Star Road"}, Abbreviation: "sr", Platforms: []string{"n64"}, GameTypes: []string{"rh"}, Developers: []string{"skelux"}, Links: []speedrun.Link{marioLink, {Rel: "base-game", URI: baseURL + "/games/sm64"}}},
+		},
+		GameTypes: []*speedrun.GameType{
+			{ID: "rh", Name: "ROM Hack", AllowsBaseGame: true},
+			{ID: "fan", Name: "Fangame"},
+		},
+		Developers: []*speedrun.Developer{
+			{ID: "ead", Name: "Nintendo EAD"},
+			{ID: "skelux", Name: "Skelux"},
+		},
+		Publishers: []*speedrun.Publisher{{ID: "nintendo", Name: "Nintendo"}},
+		Series: []*speedrun.Series{
+			{
+				ID:           "mario",
+				Names:        speedrun.GameNames{International: "Super Mario", Japanese: "スーパーマリオ"},
+				Abbreviation: "mario",
+				Moderators:   map[string]speedrun.GameModeratorRole{"u2": speedrun.Moderator, "u1": speedrun.SuperModerator},
+				Assets:       map[speedrun.GameAssetKind]*speedrun.GameAsset{speedrun.AssetLogo: {URI: "https://www.speedrun.com/mario/logo.png", Width: 64, Height: 32}, speedrun.AssetIcon: nil},
+			},
+			{ID: "zelda", Names: speedrun.GameNames{International: "The Legend of Zelda"}, Abbreviation: "zelda"},
+		},
+		Categories: []*speedrun.Category{
+			{ID: "120", Name: "120 Star", Type: speedrun.CategoryPerGame, Links: gameLink},
+			{ID: "16", Name: "16 Star", Type: speedrun.CategoryPerGame, Links: gameLink},
+			{ID: "il", Name: "IL", Type: speedrun.CategoryPerLevel, Links: gameLink},
+			{ID: "any", Name: "Any%", Type: speedrun.CategoryPerGame, Links: []speedrun.Link{{Rel: "game", URI: baseURL + "/games/oot"}}},
+		},
+		Levels: []*speedrun.Level{{ID: "bob", Name: "Bob-omb Battlefield", Links: gameLink}},
+		Variables: []*speedrun.Variable{
+			{ID: "ver", Name: "Version", CategoryID: "120", Scope: speedrun.VariableScope{Type: speedrun.ScopeFullGame}, Links: gameLink},
+			{ID: "plat", Name: "Platform", Scope: speedrun.VariableScope{Type: speedrun.ScopeGlobal}, Links: gameLink},
+			{ID: "star", Name: "Star", Scope: speedrun.VariableScope{Type: speedrun.ScopeSingleLevel, LevelID: "bob"}, Links: gameLink},
+		},
+	}
+
+	countries := []string{"jp", "us", ""}
+	for i := 1; i <= 8; i++ {
+		u := &speedrun.User{ID: fmt.Sprintf("u%d", i), Names: speedrun.UserNames{International: fmt.Sprintf("Runner%d", i)}}
+		if c := countries[(i-1)%len(countries)]; c != "" {
+			u.Location = &speedrun.UserLocation{Country: &speedrun.Location{Code: c}}
+		}
+		d.Users = append(d.Users, u)
+	}
+
+	times := []float64{6000, 6100, 6100, 6200, 6300, 6400, 6500, 6600}
+	for i, t := range times {
+		d.Runs = append(d.Runs, &speedrun.Run{
+			ID:         fmt.Sprintf("r%d", i+1),
+			GameID:     "sm64",
+			CategoryID: "120",
+			Status:     speedrun.RunStatus{Status: speedrun.RunVerified},
+			Players:    []speedrun.RunPlayer{{Rel: speedrun.PlayerUser, ID: fmt.Sprintf("u%d", i+1)}},
+			Times:      speedrun.RunTimes{Primary: t, RealTime: t},
+			System:     speedrun.RunSystem{PlatformID: "n64"},
+		})
+	}
+	d.APIKeys = map[string]string{"secret": "u1"}
+	d.Notifications = map[string][]*speedrun.Notification{
+		"u1": {
+			{ID: "n1", Text: "A run was submitted", Item: speedrun.Link{Rel: "run", URI: "https://www.speedrun.com/sm64/run/r2"}, Links: []speedrun.Link{{Rel: "run", URI: baseURL + "/runs/r2"}, {Rel: "game", URI: baseURL + "/games/sm64"}}},
+			{ID: "n2", Text: "Game updated", Item: speedrun.Link{Rel: "game", URI: "https://www.speedrun.com/sm64"}, Links: []speedrun.Link{{Rel: "game", URI: baseURL + "/games/sm64"}}},
+			{ID: "n3", Text: "New post", Item: speedrun.Link{Rel: "post", URI: "https://www.speedrun.com/sm64/thread/abc"}, Links: []speedrun.Link{{Rel: "game", URI: baseURL + "/games/sm64"}}},
+			{ID: "n4", Text: "New message", Item: speedrun.Link{Rel: "post", URI: "https://www.speedrun.com/inbox"}},
+		},
+	}
+
+	d.Runs = append(d.Runs, &speedrun.Run{
+		ID:         "o1",
+		GameID:     "oot",
+		CategoryID: "any",
+		Status:     speedrun.RunStatus{Status: speedrun.RunVerified},
+		Players:    []speedrun.RunPlayer{{Rel: speedrun.PlayerUser, ID: "u1"}},
+		Times:      speedrun.RunTimes{Primary: 7000},
+	}, &speedrun.Run{
+		ID:         "g1",
+		GameID:     "sm64",
+		CategoryID: "16",
+		Status:     speedrun.RunStatus{Status: speedrun.RunVerified},
+		Players:    []speedrun.RunPlayer{{Rel: speedrun.PlayerGuest, Name: "Guesty"}},
+		Times:      speedrun.RunTimes{Primary: 900},
+	})
+	return d
+}
+
+// testServer executes GraphQL queries against resolvers that use a fake
+// speedrun.com server.
+type testServer struct {
+	*speedruntest.Server
+	handler *handler
+
+	// apiKey is sent with queries when it's set.
+	apiKey string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	s := speedruntest.NewServer(nil)
+	t.Cleanup(s.Close)
+	s.Update(func(d *speedruntest.Dataset) {
+		*d = *testDataset(s.BaseURL())
+	})
+
+	return &testServer{
+		Server:  s,
+		handler: newTestHandler(t, s, SelectionTracer{Tracer: trace.NoopTracer{}}),
+	}
+}
+
+// newTestHandler creates a handler for resolvers using the server s, which
+// traces queries with tracer.
+func newTestHandler(t *testing.T, s *speedruntest.Server, tracer trace.Tracer) *handler {
+	t.Helper()
+
+	schemaData, err := ioutil.ReadFile("../schema.graphql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := New(s.NewClient())
+	schema, err := graphql.ParseSchema(string(schemaData), r,
+		graphql.UseFieldResolvers(),
+		graphql.Tracer(tracer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r.Handler(schema).(*handler)
+}
+
+type testResponse struct {
+	Data   json.RawMessage
+	Errors []struct {
+		Message    string
+		Path       []interface{}
+		Extensions map[string]interface{}
+	}
+}
+
+// query executes q and decodes its data into v. It fails the test if there are
+// any errors, and returns the number of requests made to speedrun.com.
+func (s *testServer) query(t *testing.T, q string, vars map[string]interface{}, v interface{}) int {
+	t.Helper()
+
+	res, n := s.exec(t, q, vars)
+	if len(res.Errors) > 0 {
+		t.Fatalf("query returned errors: %+v", res.Errors)
+	}
+	if err := json.Unmarshal(res.Data, v); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// exec executes q, returning its response and the number of requests made to
+// speedrun.com.
+func (s *testServer) exec(t *testing.T, q string, vars map[string]interface{}) (*testResponse, int) {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     q,
+		"variables": vars,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := s.Requests()
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader(body))
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, req)
+
+	var res testResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decoding %s: %v", w.Body.String(), err)
+	}
+	return &res, s.Requests() - before
+}
+
+func TestGame(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp struct {
+		Game struct {
+			ID         graphql.ID
+			Name       string
+			Platforms  []struct{ Name string }
+			Categories []struct {
+				Name string
+				Game struct{ Name string }
+			}
+		}
+	}
+	n := s.query(t, `query($id: ID!) {
+		game(id: $id) {
+			id
+			name
+			platforms { name }
+			categories { name game { name } }
+		}
+	}`, map[string]interface{}{"id": relay.MarshalID("game", "sm64")}, &resp)
+
+	if resp.Game.Name != "Super Mario 64" {
+		t.Errorf("game name = %q, want Super Mario 64", resp.Game.Name)
+	}
+	if len(resp.Game.Platforms) != 1 || resp.Game.Platforms[0].Name != "Nintendo 64" {
+		t.Errorf("platforms = %v, want Nintendo 64", resp.Game.Platforms)
+	}
+	if len(resp.Game.Categories) != 3 {
+		t.Fatalf("got %d categories, want 3", len(resp.Game.Categories))
+	}
+	for _, c := range resp.Game.Categories {
+		if c.Game.Name != "Super Mario 64" {
+			t.Errorf("category %s has game %q", c.Name, c.Game.Name)
+		}
+	}
+	// The game, its platforms and its categories. Each category's game is
+	// already loaded.
+	if n != 3 {
+		t.Errorf("made %d requests, want 3", n)
+	}
+}
+
+func TestGameNotFound(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp struct{ Game *struct{ Name string } }
+	s.query(t, `{ game(id: "Z2FtZToibm9wZSI=") { name } }`, nil, &resp)
+	if resp.Game != nil {
+		t.Errorf("game = %v, want null", resp.Game)
+	}
+}
+
+func TestGamesConnection(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp struct {
+		Viewer struct {
+			Games struct {
+				Edges []struct {
+					Cursor string
+					Node   struct{ Name string }
+				}
+				PageInfo struct{ HasNextPage, HasPreviousPage bool }
+			}
+		}
+	}
+	s.query(t, `{
+		viewer {
+			games(first: 1, order: {field: NAME_INT}) {
+				edges { cursor node { name } }
+				pageInfo { hasNextPage hasPreviousPage }
+			}
+		}
+	}`, nil, &resp)
+
+	games := resp.Viewer.Games
+	if len(games.Edges) != 1 || games.Edges[0].Node.Name != "Ocarina of Time" {
+		t.Fatalf("first page = %+v, want Ocarina of Time", games.Edges)
+	}
+	if !games.PageInfo.HasNextPage || games.PageInfo.HasPreviousPage {
+		t.Errorf("first page info = %+v, want only a next page", games.PageInfo)
+	}
+
+	s.query(t, `query($after: Cursor) {
+		viewer {
+			games(first: 5, after: $after, order: {field: NAME_INT}) {
+				edges { cursor node { name } }
+				pageInfo { hasNextPage hasPreviousPage }
+			}
+		}
+	}`, map[string]interface{}{"after": games.Edges[0].Cursor}, &resp)
+
+	games = resp.Viewer.Games
+	if len(games.Edges) != 2 || games.Edges[0].Node.Name != "Star Road" || games.Edges[1].Node.Name != "Super Mario 64" {
+		t.Fatalf("second page = %+v, want Star Road and Super Mario 64", games.Edges)
+	}
+	if games.PageInfo.HasNextPage || !games.PageInfo.HasPreviousPage {
+		t.Errorf("second page info = %+v, want only a previous page", games.PageInfo)
+	}
+}
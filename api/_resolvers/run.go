@@ -33,39 +33,34 @@ type FetchRunsArgs struct {
 		Field     *RunOrderField
 		Direction *speedrun.OrderDirection
 	}
-	First *int32
-	After *Cursor
+	Embed *[]RunEmbed
+	PageArgs
 }
 
 func fetchRunConnection(ctx context.Context, c *speedrun.Client, args FetchRunsArgs, extraOpts ...speedrun.FetchOption) (*RunConnection, error) {
 	var opts []speedrun.FetchOption
 	opts = append(opts, extraOpts...)
+	opts = append(opts, withEmbeds(args.Embed, planRunConnectionEmbeds(selectionFromContext(ctx))))
 	if args.Order != nil {
 		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
 	}
 	if args.Filter != nil {
 		opts = append(opts, speedrun.WithFilters(*args.Filter))
 	}
-	if args.First != nil {
-		opts = append(opts, speedrun.WithLimit(int(*args.First)))
-	}
-	if args.After != nil {
-		offset, err := args.After.GetOffset()
-		if err != nil {
-			return nil, err
-		}
-		opts = append(opts, speedrun.WithOffset(offset))
-	}
 
-	runs, pageInfo, err := c.ListRuns(ctx, opts...)
+	var runs []*speedrun.Run
+	page, err := args.fetch(ctx, "run", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		runs, pi, err = c.ListRuns(ctx, opts...)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &RunConnection{
-		client:   c,
-		runs:     runs,
-		pageInfo: pageInfo,
+		client: c,
+		runs:   runs,
+		page:   page,
 	}, nil
 }
 
@@ -92,16 +87,17 @@ func (v *RunOrderField) UnmarshalGraphQL(input interface{}) error {
 }
 
 type RunConnection struct {
-	client   *speedrun.Client
-	runs     []*speedrun.Run
-	pageInfo *speedrun.PageInfo
+	client *speedrun.Client
+	runs   []*speedrun.Run
+	page   *listPage
 }
 
 func (rc *RunConnection) Edges() []*RunEdge {
 	var edges []*RunEdge
-	for _, r := range rc.runs {
+	for i, r := range rc.runs {
 		edges = append(edges, &RunEdge{
-			Node: &Run{*r, rc.client},
+			Node:   &Run{*r, rc.client},
+			cursor: rc.page.cursor(i),
 		})
 	}
 	return edges
@@ -116,15 +112,24 @@ func (rc *RunConnection) Nodes() []*Run {
 }
 
 func (rc *RunConnection) PageInfo() *PageInfo {
-	return &PageInfo{rc.pageInfo}
+	return rc.page.pageInfo()
+}
+
+func (rc *RunConnection) TotalCount() (*int32, error) {
+	return rc.page.totalCount()
+}
+
+func (rc *RunConnection) PageSize() int32 {
+	return rc.page.pageSize()
 }
 
 type RunEdge struct {
-	Node *Run
+	Node   *Run
+	cursor Cursor
 }
 
-func (e *RunEdge) Cursor() *Cursor {
-	return nil
+func (e *RunEdge) Cursor() Cursor {
+	return e.cursor
 }
 
 type Run struct {
@@ -405,7 +410,7 @@ func (rp *RunPlayer) ToGuestRunPlayer() (*GuestRunPlayer, bool) {
 		return nil, false
 	}
 
-	return &GuestRunPlayer{rp.RunPlayer}, true
+	return &GuestRunPlayer{rp.RunPlayer, rp.client}, true
 }
 
 type UserRunPlayer struct {
@@ -428,4 +433,18 @@ func (urp *UserRunPlayer) User(ctx context.Context) (*User, error) {
 
 type GuestRunPlayer struct {
 	speedrun.RunPlayer
+	client *speedrun.Client
+}
+
+func (grp *GuestRunPlayer) Guest(ctx context.Context) (*Guest, error) {
+	guest, err := grp.client.GetGuest(ctx, grp.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if guest == nil {
+		return nil, nil
+	}
+
+	return &Guest{*guest, grp.client}, nil
 }
@@ -0,0 +1,399 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mjm/graphql-go/introspection"
+	"github.com/mjm/graphql-go/trace"
+)
+
+// SelectionTracer tracks which fields a query selects below the field being
+// resolved, so that resolvers can plan their upstream requests up front
+// instead of discovering what they need one field at a time.
+//
+// graphql-go doesn't offer resolvers their selection sets, but a tracer sees
+// the query before it runs and each field as it is resolved. Traces are passed
+// on to Tracer.
+//
+// Planning is best-effort. The schema may be built without this tracer, and
+// queries it can't parse aren't planned, so selectionFromContext(ctx) can be
+// nil in any resolver. Resolvers must return the same results when it is, at
+// the cost of more requests to speedrun.com.
+type SelectionTracer struct {
+	trace.Tracer
+}
+
+type selectionKey struct{}
+
+func (t SelectionTracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	if sel := parseSelection(queryString, operationName); sel != nil {
+		ctx = context.WithValue(ctx, selectionKey{}, sel)
+	}
+	return t.Tracer.TraceQuery(ctx, queryString, operationName, variables, varTypes)
+}
+
+func (t SelectionTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	if sel := selectionFromContext(ctx); sel != nil {
+		ctx = context.WithValue(ctx, selectionKey{}, sel.field(fieldName))
+	}
+	return t.Tracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+}
+
+// selectionFromContext returns the fields selected below the field being
+// resolved, or nil if they aren't known.
+func selectionFromContext(ctx context.Context) *selectionSet {
+	sel, _ := ctx.Value(selectionKey{}).(*selectionSet)
+	return sel
+}
+
+// selectionSet is the set of fields selected on an object, keyed by field name,
+// along with the names of the arguments passed to the field it was selected on.
+//
+// Aliases, fragments and type conditions are all merged together, and
+// directives are ignored, so a selection set may include fields that won't
+// actually be resolved. That's good enough to plan with.
+type selectionSet struct {
+	fields map[string]*selectionSet
+	args   map[string]bool
+}
+
+// field returns the fields selected below the field at path, or nil if it isn't
+// selected.
+func (s *selectionSet) field(path ...string) *selectionSet {
+	for _, name := range path {
+		if s == nil {
+			return nil
+		}
+		s = s.fields[name]
+	}
+	return s
+}
+
+// has reports whether the field at path is selected.
+func (s *selectionSet) has(path ...string) bool {
+	return s.field(path...) != nil
+}
+
+// hasArg reports whether the field was given the named argument. The argument
+// may still be null if its value is a variable.
+func (s *selectionSet) hasArg(name string) bool {
+	return s != nil && s.args[name]
+}
+
+func (s *selectionSet) merge(other *selectionSet) {
+	for name := range other.args {
+		s.args[name] = true
+	}
+	for name, sub := range other.fields {
+		if s.fields[name] == nil {
+			s.fields[name] = newSelectionSet()
+		}
+		s.fields[name].merge(sub)
+	}
+}
+
+func newSelectionSet() *selectionSet {
+	return &selectionSet{
+		fields: make(map[string]*selectionSet),
+		args:   make(map[string]bool),
+	}
+}
+
+// parseSelection finds the fields selected by an operation in a query, which
+// must already be valid. It returns nil if the query can't be understood.
+func parseSelection(queryString string, operationName string) *selectionSet {
+	p := &selectionParser{
+		tokens:    tokenizeQuery(queryString),
+		fragments: make(map[string]*parsedSelectionSet),
+	}
+
+	var op *parsedSelectionSet
+	for p.more() {
+		switch p.peek() {
+		case "{":
+			if op == nil {
+				op = p.selectionSet()
+			}
+		case "fragment":
+			p.next()
+			name := p.next()
+			p.next() // on
+			p.next() // type condition
+			p.directives()
+			p.fragments[name] = p.selectionSet()
+		case "query", "mutation", "subscription":
+			p.next()
+			var name string
+			if p.peek() != "(" && p.peek() != "@" && p.peek() != "{" {
+				name = p.next()
+			}
+			p.skipGroup("(", ")")
+			p.directives()
+			sel := p.selectionSet()
+			if op == nil || name == operationName {
+				op = sel
+			}
+		default:
+			return nil
+		}
+
+		if p.failed {
+			return nil
+		}
+	}
+
+	if op == nil {
+		return nil
+	}
+	return p.expand(op, make(map[string]bool))
+}
+
+// parsedSelectionSet is a selection set as written in the query, before
+// fragment spreads are expanded.
+type parsedSelectionSet struct {
+	fields  map[string][]parsedField
+	spreads []string
+}
+
+// parsedField is one place a field is selected in the query.
+type parsedField struct {
+	args []string
+	sel  *parsedSelectionSet
+}
+
+type selectionParser struct {
+	tokens    []string
+	pos       int
+	fragments map[string]*parsedSelectionSet
+	failed    bool
+}
+
+func (p *selectionParser) more() bool {
+	return !p.failed && p.pos < len(p.tokens)
+}
+
+func (p *selectionParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		p.failed = true
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *selectionParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// skipGroup skips over a balanced group of tokens, such as arguments or
+// variable definitions, if one starts at the current token.
+func (p *selectionParser) skipGroup(open, close string) {
+	if p.peek() != open {
+		return
+	}
+
+	depth := 0
+	for p.more() {
+		switch p.next() {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+// arguments reads the names of the arguments in a field's argument list, if
+// one starts at the current token.
+func (p *selectionParser) arguments() []string {
+	if p.peek() != "(" {
+		return nil
+	}
+	p.next()
+
+	var names []string
+	for p.more() && p.peek() != ")" {
+		names = append(names, p.next())
+		if p.next() != ":" {
+			p.failed = true
+			return nil
+		}
+		p.value()
+	}
+	p.next()
+	return names
+}
+
+// value skips over a single value.
+func (p *selectionParser) value() {
+	switch p.peek() {
+	case "{":
+		p.skipGroup("{", "}")
+	case "[":
+		p.skipGroup("[", "]")
+	case "$":
+		p.next()
+		p.next()
+	default:
+		p.next()
+	}
+}
+
+func (p *selectionParser) directives() {
+	for p.more() && p.peek() == "@" {
+		p.next()
+		p.next()
+		p.skipGroup("(", ")")
+	}
+}
+
+func (p *selectionParser) selectionSet() *parsedSelectionSet {
+	sel := &parsedSelectionSet{fields: make(map[string][]parsedField)}
+	if p.next() != "{" {
+		p.failed = true
+		return sel
+	}
+
+	for p.more() && p.peek() != "}" {
+		if p.peek() == "..." {
+			p.next()
+			switch p.peek() {
+			case "on":
+				p.next()
+				p.next()
+				fallthrough
+			case "@", "{":
+				p.directives()
+				inline := p.selectionSet()
+				for name, fields := range inline.fields {
+					sel.fields[name] = append(sel.fields[name], fields...)
+				}
+				sel.spreads = append(sel.spreads, inline.spreads...)
+			default:
+				sel.spreads = append(sel.spreads, p.next())
+				p.directives()
+			}
+			continue
+		}
+
+		name := p.next()
+		if p.peek() == ":" {
+			p.next()
+			name = p.next()
+		}
+		field := parsedField{args: p.arguments()}
+		p.directives()
+
+		if p.peek() == "{" {
+			field.sel = p.selectionSet()
+		}
+		sel.fields[name] = append(sel.fields[name], field)
+	}
+	p.next()
+
+	return sel
+}
+
+// expand merges the fields selected directly and through fragments.
+func (p *selectionParser) expand(sel *parsedSelectionSet, visiting map[string]bool) *selectionSet {
+	res := newSelectionSet()
+	if sel == nil {
+		return res
+	}
+
+	for name, fields := range sel.fields {
+		field := newSelectionSet()
+		for _, f := range fields {
+			for _, arg := range f.args {
+				field.args[arg] = true
+			}
+			field.merge(p.expand(f.sel, visiting))
+		}
+		res.fields[name] = field
+	}
+
+	for _, name := range sel.spreads {
+		frag, ok := p.fragments[name]
+		if !ok || visiting[name] {
+			continue
+		}
+
+		visiting[name] = true
+		res.merge(p.expand(frag, visiting))
+		visiting[name] = false
+	}
+
+	return res
+}
+
+// tokenizeQuery splits a GraphQL query into names and punctuation. Values that
+// don't affect which fields are selected, like strings and numbers, are kept as
+// single tokens so they can be skipped.
+func tokenizeQuery(q string) []string {
+	var tokens []string
+	for i := 0; i < len(q); {
+		c := q[i]
+		j := i + 1
+		switch {
+		case c == '#':
+			for j < len(q) && q[j] != '\n' && q[j] != '\r' {
+				j++
+			}
+			i = j
+			continue
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i = j
+			continue
+		case strings.HasPrefix(q[i:], "\ufeff"):
+			i += len("\ufeff")
+			continue
+		case strings.HasPrefix(q[i:], `"""`):
+			j = i + 3
+			for j < len(q) && !strings.HasPrefix(q[j:], `"""`) {
+				if strings.HasPrefix(q[j:], `\"""`) {
+					j += 3
+				}
+				j++
+			}
+			j += 3
+		case c == '"':
+			for j < len(q) && q[j] != '"' {
+				if q[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j++
+		case strings.HasPrefix(q[i:], "..."):
+			j = i + 3
+		case c == '-' || isDigit(c):
+			for j < len(q) && (isNameByte(q[j]) || q[j] == '.' || q[j] == '+' || q[j] == '-') {
+				j++
+			}
+		case isNameByte(c):
+			for j < len(q) && isNameByte(q[j]) {
+				j++
+			}
+		}
+
+		if j > len(q) {
+			j = len(q)
+		}
+		tokens = append(tokens, q[i:j])
+		i = j
+	}
+	return tokens
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
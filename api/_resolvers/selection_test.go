@@ -0,0 +1,366 @@
+package resolvers
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+	"github.com/mjm/graphql-go/trace"
+)
+
+// formatSelection writes a selection set in a compact form with its fields
+// sorted, like "game{name runs{nodes}}".
+func formatSelection(s *selectionSet) string {
+	if s == nil {
+		return "<nil>"
+	}
+
+	var names []string
+	for name := range s.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parts []string
+	for _, name := range names {
+		if sub := s.fields[name]; len(sub.fields) > 0 {
+			name += "{" + formatSelection(sub) + "}"
+		}
+		parts = append(parts, name)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestParseSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		operation string
+		want      string
+	}{
+		{
+			"shorthand",
+			`{ viewer { games { nodes { name } } } }`,
+			"",
+			"viewer{games{nodes{name}}}",
+		},
+		{
+			"arguments",
+			`{ game(id: "abc", filter: {platform: "n64", list: [1, 2.5e3, -3]}) { name(variant: JAPANESE) } }`,
+			"",
+			"game{name}",
+		},
+		{
+			"aliases",
+			`{ a: game(id: "1") { n: name } b: game(id: "2") { abbreviation } }`,
+			"",
+			"game{abbreviation name}",
+		},
+		{
+			"fragments",
+			`query { game(id: "1") { ...GameFields categories { ...CategoryFields } } }
+			fragment GameFields on Game { name platforms { name } }
+			fragment CategoryFields on Category { name game { ...GameFields } }`,
+			"",
+			"game{categories{game{name platforms{name}} name} name platforms{name}}",
+		},
+		{
+			"fragment defined first",
+			`fragment F on Game { name } { game(id: "1") { ...F } }`,
+			"",
+			"game{name}",
+		},
+		{
+			"recursive fragments",
+			`{ game(id: "1") { ...A } } fragment A on Game { name ...B } fragment B on Game { abbreviation ...A }`,
+			"",
+			"game{abbreviation name}",
+		},
+		{
+			"missing fragment",
+			`{ game(id: "1") { name ...Missing } }`,
+			"",
+			"game{name}",
+		},
+		{
+			"inline fragments",
+			`{ node(id: "1") { id ... on Game { name } ... on Run { players { ... on UserRunPlayer { user { name } } ... on GuestRunPlayer { name } } } } }`,
+			"",
+			"node{id name players{name user{name}}}",
+		},
+		{
+			"inline fragments without type conditions",
+			`query($x: Boolean!) { game(id: "1") { ... { name } ... @include(if: $x) { abbreviation } } }`,
+			"",
+			"game{abbreviation name}",
+		},
+		{
+			"directives",
+			`query($x: Boolean!) { game(id: "1") @skip(if: $x) { name @include(if: $x) ...F @skip(if: false) } } fragment F on Game @deprecated { abbreviation }`,
+			"",
+			"game{abbreviation name}",
+		},
+		{
+			"block strings",
+			`{ game(id: """ } { \""" weblink """) { name } }`,
+			"",
+			"game{name}",
+		},
+		{
+			"strings",
+			`{ game(id: "\"}{ weblink") { name } }`,
+			"",
+			"game{name}",
+		},
+		{
+			"comments",
+			"{\n  # game { weblink }\n  game(id: \"1\") { name } # }\n}",
+			"",
+			"game{name}",
+		},
+		{
+			"variables",
+			`query Game($id: ID! = "x", $first: Int = 3, $filter: GameFilter = {name: "{"}) @live { game(id: $id) { runs(first: $first) { nodes { id } } } }`,
+			"",
+			"game{runs{nodes{id}}}",
+		},
+		{
+			"named operations",
+			`query A { viewer { games { nodes { name } } } } query B { game(id: "1") { name } }`,
+			"B",
+			"game{name}",
+		},
+		{
+			"first operation by default",
+			`query A { viewer { games { nodes { name } } } } query B { game(id: "1") { name } }`,
+			"",
+			"viewer{games{nodes{name}}}",
+		},
+		{
+			"commas and byte order mark",
+			"\ufeff{ game(id: \"1\"), { name, abbreviation, } }",
+			"",
+			"game{abbreviation name}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel := parseSelection(tt.query, tt.operation)
+			if got := formatSelection(sel); got != tt.want {
+				t.Errorf("parseSelection() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSelectionArgs(t *testing.T) {
+	sel := parseSelection(`query($n: Int) {
+		lb: leaderboard(game: "1", category: "2", variables: [{id: "a", value: "b"}]) {
+			runs(country: "jp", first: $n) { nodes { place } }
+		}
+		leaderboard(game: "1", category: "2", embed: [PLAYERS]) {
+			runs(after: "()") { nodes { place } }
+			game { name }
+		}
+	}`, "")
+
+	lb := sel.field("leaderboard")
+	for _, arg := range []string{"game", "category", "variables", "embed"} {
+		if !lb.hasArg(arg) {
+			t.Errorf("leaderboard doesn't have argument %s", arg)
+		}
+	}
+	for _, arg := range []string{"country", "first", "after"} {
+		if !lb.field("runs").hasArg(arg) {
+			t.Errorf("runs doesn't have argument %s", arg)
+		}
+	}
+	if lb.field("runs").hasArg("last") || lb.field("game").hasArg("id") || lb.field("runs", "nodes").hasArg("country") {
+		t.Errorf("found arguments that weren't given")
+	}
+	if got := formatSelection(lb); got != "game{name} runs{nodes{place}}" {
+		t.Errorf("leaderboard selection = %s", got)
+	}
+
+	fragSel := parseSelection(`{ viewer { ...F } } fragment F on Viewer { games(first: 2) { nodes { id } } }`, "")
+	if !fragSel.field("viewer", "games").hasArg("first") {
+		t.Errorf("argument in a fragment wasn't found")
+	}
+}
+
+func TestParseSelectionInvalid(t *testing.T) {
+	for _, q := range []string{
+		``,
+		`{ game(id: "1") { name }`,
+		`type Query { game: Game }`,
+		`fragment F on Game { name }`,
+	} {
+		if sel := parseSelection(q, ""); sel != nil {
+			t.Errorf("parseSelection(%q) = %s, want nil", q, formatSelection(sel))
+		}
+	}
+}
+
+func TestTokenizeQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{`{ a }`, []string{"{", "a", "}"}},
+		{`a(b: $c)`, []string{"a", "(", "b", ":", "$", "c", ")"}},
+		{`...F ... on T`, []string{"...", "F", "...", "on", "T"}},
+		{`a(n: -1.5e+10)`, []string{"a", "(", "n", ":", "-1.5e+10", ")"}},
+		{`"a \"b\" c" d`, []string{`"a \"b\" c"`, "d"}},
+		{`"""a "" \""" b""" c`, []string{`"""a "" \""" b"""`, "c"}},
+		{"a # b { c\nd", []string{"a", "d"}},
+		{`a, b,,c`, []string{"a", "b", "c"}},
+		{`"unterminated`, []string{`"unterminated`}},
+		{`"""unterminated`, []string{`"""unterminated`}},
+	}
+
+	for _, tt := range tests {
+		if got := tokenizeQuery(tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("tokenizeQuery(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSelectionField(t *testing.T) {
+	sel := parseSelection(`{ game(id: "1") { runs { nodes { game { name } } } } }`, "")
+
+	if !sel.has("game", "runs", "nodes", "game") {
+		t.Errorf("has(game runs nodes game) = false")
+	}
+	if sel.has("game", "runs", "edges") {
+		t.Errorf("has(game runs edges) = true")
+	}
+	if got := formatSelection(sel.field("game", "runs")); got != "nodes{game{name}}" {
+		t.Errorf("field(game runs) = %s", got)
+	}
+
+	var unknown *selectionSet
+	if unknown.has("game") || unknown.field("game") != nil {
+		t.Errorf("nil selection set has fields")
+	}
+}
+
+func TestSelectionPlansEmbeds(t *testing.T) {
+	s := newTestServer(t)
+	unplanned := newTestHandler(t, s.Server, trace.NoopTracer{})
+
+	q := `query($game: ID) {
+		viewer {
+			runs(first: 8, filter: {game: $game, status: VERIFIED}) {
+				nodes {
+					game { name }
+					category { name }
+					players { ... on UserRunPlayer { user { name } } }
+				}
+			}
+		}
+	}`
+
+	var resp struct {
+		Viewer struct {
+			Runs struct {
+				Nodes []struct {
+					Game     struct{ Name string }
+					Category struct{ Name string }
+					Players  []struct{ User struct{ Name string } }
+				}
+			}
+		}
+	}
+	vars := map[string]interface{}{"game": relay.MarshalID("game", "sm64")}
+	planned := s.query(t, q, vars, &resp)
+
+	runs := resp.Viewer.Runs.Nodes
+	if len(runs) != 8 {
+		t.Fatalf("got %d runs, want 8", len(runs))
+	}
+	for _, r := range runs {
+		if r.Game.Name != "Super Mario 64" || r.Category.Name == "" || len(r.Players) != 1 || r.Players[0].User.Name == "" {
+			t.Errorf("run resolved to %+v, want its game, category and player", r)
+		}
+	}
+
+	s.handler = unplanned
+	unplannedRequests := s.query(t, q, vars, &resp)
+
+	// With embeds, everything comes back with the runs. Without them, the
+	// game, category and each player need their own requests.
+	if planned != 1 {
+		t.Errorf("made %d requests with the selection tracer, want 1", planned)
+	}
+	if unplannedRequests != 11 {
+		t.Errorf("made %d requests without the selection tracer, want 11", unplannedRequests)
+	}
+}
+
+func TestSelectionTracerOperationName(t *testing.T) {
+	s := newTestServer(t)
+
+	body := `{"query": "query A { viewer { runs(first: 2) { nodes { id } } } } query B { viewer { runs(first: 2) { nodes { game { name } } } } }", "operationName": "B"}`
+	before := s.Requests()
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, httptest.NewRequest("POST", "/graphql", strings.NewReader(body)))
+	if n := s.Requests() - before; n != 1 {
+		t.Errorf("made %d requests for operation B, want 1 with the game embedded: %s", n, w.Body.String())
+	}
+}
+
+func TestSelectionTracerOptional(t *testing.T) {
+	s := newTestServer(t)
+	unplanned := newTestHandler(t, s.Server, trace.NoopTracer{})
+
+	q := `query($game: ID!, $category: ID!, $user: ID!) {
+		viewer {
+			runs(first: 10, filter: {game: $game}) {
+				nodes {
+					rawID
+					game { name }
+					category { name }
+					level { name }
+					players {
+						... on UserRunPlayer { user { name } }
+						... on GuestRunPlayer { name }
+					}
+				}
+			}
+			leaderboard(game: $game, category: $category) {
+				game { name }
+				category { name }
+				runs(country: "jp", first: 5) { nodes { place run { rawID players { ... on UserRunPlayer { user { name } } } } } }
+			}
+		}
+		game(id: $game) {
+			records(top: 3) {
+				category { name }
+				runs(country: "us") { nodes { place run { rawID } } }
+			}
+		}
+		node(id: $user) {
+			... on User { personalBests { place run { rawID game { name } category { name } } } }
+		}
+	}`
+	vars := map[string]interface{}{
+		"game":     relay.MarshalID("game", "sm64"),
+		"category": relay.MarshalID("category", "120"),
+		"user":     relay.MarshalID("user", "u1"),
+	}
+
+	planned, _ := s.exec(t, q, vars)
+	s.handler = unplanned
+	res, _ := s.exec(t, q, vars)
+
+	if len(planned.Errors) > 0 || len(res.Errors) > 0 {
+		t.Fatalf("errors with the selection tracer = %+v, without = %+v", planned.Errors, res.Errors)
+	}
+	if string(planned.Data) != string(res.Data) {
+		t.Errorf("results differ without the selection tracer:\nwith:    %s\nwithout: %s", planned.Data, res.Data)
+	}
+}
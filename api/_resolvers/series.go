@@ -0,0 +1,208 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/mjm/graphql-go"
+	"github.com/mjm/graphql-go/relay"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (v *Viewer) Series(ctx context.Context, args struct {
+	Filter *struct {
+		Name         *string     `filter:"name"`
+		Abbreviation *string     `filter:"abbreviation"`
+		Moderator    *graphql.ID `filter:"moderator"`
+	}
+	Order *struct {
+		Field     *SeriesOrderField
+		Direction *speedrun.OrderDirection
+	}
+	PageArgs
+}) (*SeriesConnection, error) {
+	var opts []speedrun.FetchOption
+	if args.Order != nil {
+		opts = append(opts, speedrun.WithOrder((*string)(args.Order.Field), args.Order.Direction))
+	}
+	if args.Filter != nil {
+		opts = append(opts, speedrun.WithFilters(args.Filter))
+	}
+
+	var series []*speedrun.Series
+	page, err := args.fetch(ctx, "series", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		series, pi, err = v.client.ListSeries(ctx, opts...)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeriesConnection{
+		client: v.client,
+		series: series,
+		page:   page,
+	}, nil
+}
+
+type SeriesOrderField string
+
+func (SeriesOrderField) ImplementsGraphQLType(name string) bool {
+	return name == "SeriesOrderField"
+}
+
+func (v *SeriesOrderField) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("SeriesOrderField value was not a string")
+	}
+
+	switch s {
+	case "NAME_INT":
+		*v = "name.int"
+	case "NAME_JAP":
+		*v = "name.jap"
+	default:
+		*v = SeriesOrderField(strings.ToLower(s))
+	}
+	return nil
+}
+
+type SeriesConnection struct {
+	client *speedrun.Client
+	series []*speedrun.Series
+	page   *listPage
+}
+
+func (sc *SeriesConnection) Edges() []*SeriesEdge {
+	var edges []*SeriesEdge
+	for i, s := range sc.series {
+		edges = append(edges, &SeriesEdge{
+			Node:   &Series{*s, sc.client},
+			cursor: sc.page.cursor(i),
+		})
+	}
+	return edges
+}
+
+func (sc *SeriesConnection) Nodes() []*Series {
+	var nodes []*Series
+	for _, s := range sc.series {
+		nodes = append(nodes, &Series{*s, sc.client})
+	}
+	return nodes
+}
+
+func (sc *SeriesConnection) PageInfo() *PageInfo {
+	return sc.page.pageInfo()
+}
+
+func (sc *SeriesConnection) TotalCount() (*int32, error) {
+	return sc.page.totalCount()
+}
+
+func (sc *SeriesConnection) PageSize() int32 {
+	return sc.page.pageSize()
+}
+
+type SeriesEdge struct {
+	Node   *Series
+	cursor Cursor
+}
+
+func (e *SeriesEdge) Cursor() Cursor {
+	return e.cursor
+}
+
+type Series struct {
+	speedrun.Series
+	client *speedrun.Client
+}
+
+func (s *Series) ID() graphql.ID {
+	return relay.MarshalID("series", s.Series.ID)
+}
+
+func (s *Series) RawID() string {
+	return s.Series.ID
+}
+
+func (s *Series) Name(args struct {
+	Variant string
+}) *string {
+	var name string
+
+	switch args.Variant {
+	case "INTERNATIONAL":
+		name = s.Names.International
+	case "JAPANESE":
+		name = s.Names.Japanese
+	case "TWITCH":
+		name = s.Names.Twitch
+	}
+
+	if name == "" {
+		return nil
+	}
+	return &name
+}
+
+func (s *Series) Abbreviation() *string {
+	if s.Series.Abbreviation == "" {
+		return nil
+	}
+	return &s.Series.Abbreviation
+}
+
+func (s *Series) Moderators() []*GameModerator {
+	var gms []*GameModerator
+	for userID, role := range s.Series.Moderators {
+		gms = append(gms, &GameModerator{
+			userID: userID,
+			role:   role,
+			client: s.client,
+		})
+	}
+
+	sort.Slice(gms, func(i, j int) bool {
+		return gms[i].userID < gms[j].userID
+	})
+	return gms
+}
+
+func (s *Series) Assets() []*GameAsset {
+	var assets []*GameAsset
+	for kind, asset := range s.Series.Assets {
+		if asset == nil {
+			continue
+		}
+
+		assets = append(assets, &GameAsset{*asset, GameAssetKind(kind)})
+	}
+
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].Kind < assets[j].Kind
+	})
+	return assets
+}
+
+func (s *Series) Asset(args struct {
+	Kind GameAssetKind
+}) *GameAsset {
+	asset := s.Series.Assets[speedrun.GameAssetKind(args.Kind)]
+	if asset == nil {
+		return nil
+	}
+
+	return &GameAsset{*asset, args.Kind}
+}
+
+func (s *Series) Games(ctx context.Context, args FetchGamesArgs) (*GameConnection, error) {
+	listGames := func(ctx context.Context, opts ...speedrun.FetchOption) ([]*speedrun.Game, *speedrun.PageInfo, error) {
+		return s.client.ListSeriesGames(ctx, s.Series.ID, opts...)
+	}
+	return fetchGameList(ctx, s.client, "series-game:"+s.Series.ID, listGames, args)
+}
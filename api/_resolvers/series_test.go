@@ -0,0 +1,137 @@
+package resolvers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestSeries(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp struct {
+		Game struct {
+			Series struct {
+				RawID      string
+				Name       string
+				Japanese   *string
+				Twitch     *string
+				Moderators []struct {
+					User struct{ RawID string }
+					Role string
+				}
+				Assets []struct {
+					Kind          string
+					URI           string
+					Width, Height int
+				}
+				Logo  *struct{ URI string }
+				Icon  *struct{ URI string }
+				Games struct {
+					Nodes      []struct{ RawID string }
+					TotalCount int
+				}
+				RomHacks struct {
+					Nodes []struct{ RawID string }
+				}
+			}
+		}
+		Other struct {
+			Series *struct{ RawID string }
+		}
+	}
+	s.query(t, `query($sm64: ID!, $oot: ID!, $rh: ID!) {
+		game(id: $sm64) {
+			series {
+				rawID
+				name
+				japanese: name(variant: JAPANESE)
+				twitch: name(variant: TWITCH)
+				moderators { user { rawID } role }
+				assets { kind uri width height }
+				logo: asset(kind: LOGO) { uri }
+				icon: asset(kind: ICON) { uri }
+				games(order: {field: NAME_INT, direction: DESC}) { nodes { rawID } totalCount }
+				romHacks: games(filter: {gameType: $rh}) { nodes { rawID } }
+			}
+		}
+		other: game(id: $oot) { series { rawID } }
+	}`, map[string]interface{}{
+		"sm64": relay.MarshalID("game", "sm64"),
+		"oot":  relay.MarshalID("game", "oot"),
+		"rh":   relay.MarshalID("gametype", "rh"),
+	}, &resp)
+
+	series := resp.Game.Series
+	if series.RawID != "mario" || series.Name != "Super Mario" {
+		t.Errorf("series = %s %q, want mario", series.RawID, series.Name)
+	}
+	if series.Japanese == nil || *series.Japanese != "スーパーマリオ" || series.Twitch != nil {
+		t.Errorf("name variants = %v, %v, want only a Japanese name", series.Japanese, series.Twitch)
+	}
+
+	var mods []string
+	for _, m := range series.Moderators {
+		mods = append(mods, m.User.RawID+":"+m.Role)
+	}
+	if want := []string{"u1:SUPER_MODERATOR", "u2:MODERATOR"}; !reflect.DeepEqual(mods, want) {
+		t.Errorf("moderators = %v, want %v", mods, want)
+	}
+
+	if len(series.Assets) != 1 || series.Assets[0].Kind != "LOGO" || series.Assets[0].Width != 64 || series.Assets[0].Height != 32 {
+		t.Errorf("assets = %+v, want the logo", series.Assets)
+	}
+	if series.Logo == nil || series.Logo.URI != "https://www.speedrun.com/mario/logo.png" || series.Icon != nil {
+		t.Errorf("logo = %v, icon = %v, want only a logo", series.Logo, series.Icon)
+	}
+
+	var games []string
+	for _, g := range series.Games.Nodes {
+		games = append(games, g.RawID)
+	}
+	if want := []string{"sm64", "sr"}; !reflect.DeepEqual(games, want) || series.Games.TotalCount != 2 {
+		t.Errorf("games = %v (%d total), want %v", games, series.Games.TotalCount, want)
+	}
+	if len(series.RomHacks.Nodes) != 1 || series.RomHacks.Nodes[0].RawID != "sr" {
+		t.Errorf("rom hacks = %v, want sr", series.RomHacks.Nodes)
+	}
+
+	if resp.Other.Series == nil || resp.Other.Series.RawID != "zelda" {
+		t.Errorf("oot series = %v, want zelda", resp.Other.Series)
+	}
+}
+
+func TestSeriesPersonalBests(t *testing.T) {
+	s := newTestServer(t)
+
+	for _, tt := range []struct {
+		series string
+		want   []string
+	}{
+		{"mario", []string{"r1"}},
+		{"zelda", []string{"o1"}},
+	} {
+		var resp struct {
+			Node struct {
+				PersonalBests []struct {
+					Run struct{ RawID string }
+				}
+			}
+		}
+		s.query(t, `query($user: ID!, $series: ID!) {
+			node(id: $user) { ... on User { personalBests(series: $series) { run { rawID } } } }
+		}`, map[string]interface{}{
+			"user":   relay.MarshalID("user", "u1"),
+			"series": relay.MarshalID("series", tt.series),
+		}, &resp)
+
+		var runs []string
+		for _, pb := range resp.Node.PersonalBests {
+			runs = append(runs, pb.Run.RawID)
+		}
+		if !reflect.DeepEqual(runs, tt.want) {
+			t.Errorf("personal bests in %s = %v, want %v", tt.series, runs, tt.want)
+		}
+	}
+}
@@ -20,13 +20,16 @@ func (v *Viewer) Users(ctx context.Context, args struct {
 		Hitbox        *string `filter:"hitbox"`
 		Twitter       *string `filter:"twitter"`
 		SpeedRunsLive *string `filter:"speedrunslive"`
+
+		// speedrun.com can't filter users by country, so it's done on each page
+		// after it's fetched.
+		Country *string
 	}
 	Order *struct {
 		Field     *UserOrderField
 		Direction *speedrun.OrderDirection
 	}
-	First *int32
-	After *Cursor
+	PageArgs
 }) (*UserConnection, error) {
 	var opts []speedrun.FetchOption
 	if args.Order != nil {
@@ -35,27 +38,37 @@ func (v *Viewer) Users(ctx context.Context, args struct {
 	if args.Filter != nil {
 		opts = append(opts, speedrun.WithFilters(*args.Filter))
 	}
-	if args.First != nil {
-		opts = append(opts, speedrun.WithLimit(int(*args.First)))
+
+	var users []*speedrun.User
+	page, err := args.fetch(ctx, "user", opts, func(opts ...speedrun.FetchOption) (pi *speedrun.PageInfo, err error) {
+		users, pi, err = v.client.ListUsers(ctx, opts...)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc := &UserConnection{
+		client: v.client,
+		users:  users,
+		page:   page,
 	}
-	if args.After != nil {
-		offset, err := args.After.GetOffset()
-		if err != nil {
-			return nil, err
-		}
-		opts = append(opts, speedrun.WithOffset(offset))
+	if args.Filter != nil && args.Filter.Country != nil {
+		uc.filterCountry(*args.Filter.Country)
 	}
+	return uc, nil
+}
 
-	users, pageInfo, err := v.client.ListUsers(ctx, opts...)
+func (r *Resolvers) Me(ctx context.Context) (*User, error) {
+	user, err := r.client.GetProfile(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &UserConnection{
-		client:   v.client,
-		users:    users,
-		pageInfo: pageInfo,
-	}, nil
+	if user == nil {
+		return nil, nil
+	}
+	return &User{*user, r.client}, nil
 }
 
 type UserOrderField string
@@ -83,16 +96,36 @@ func (v *UserOrderField) UnmarshalGraphQL(input interface{}) error {
 }
 
 type UserConnection struct {
-	client   *speedrun.Client
-	users    []*speedrun.User
-	pageInfo *speedrun.PageInfo
+	client *speedrun.Client
+	users  []*speedrun.User
+	page   *listPage
+
+	// indexes are the positions in the fetched page of each of the users, if
+	// some of them have been filtered out.
+	indexes []int
+}
+
+// filterCountry removes the users that aren't from country from the page.
+func (uc *UserConnection) filterCountry(country string) {
+	var users []*speedrun.User
+	indexes := []int{}
+	for i, user := range uc.users {
+		if inCountry(user, country) {
+			users = append(users, user)
+			indexes = append(indexes, i)
+		}
+	}
+
+	uc.users = users
+	uc.indexes = indexes
 }
 
 func (uc *UserConnection) Edges() []*UserEdge {
 	var edges []*UserEdge
-	for _, user := range uc.users {
+	for i, user := range uc.users {
 		edges = append(edges, &UserEdge{
-			Node: &User{*user, uc.client},
+			Node:   &User{*user, uc.client},
+			cursor: uc.cursor(i),
 		})
 	}
 	return edges
@@ -107,15 +140,36 @@ func (uc *UserConnection) Nodes() []*User {
 }
 
 func (uc *UserConnection) PageInfo() *PageInfo {
-	return &PageInfo{uc.pageInfo}
+	return uc.page.pageInfo()
+}
+
+func (uc *UserConnection) TotalCount() (*int32, error) {
+	// Counting users after filtering them ourselves would mean fetching all of
+	// them.
+	if uc.indexes != nil {
+		return nil, nil
+	}
+	return uc.page.totalCount()
+}
+
+func (uc *UserConnection) PageSize() int32 {
+	return uc.page.pageSize()
+}
+
+func (uc *UserConnection) cursor(i int) Cursor {
+	if uc.indexes != nil {
+		return uc.page.cursor(uc.indexes[i])
+	}
+	return uc.page.cursor(i)
 }
 
 type UserEdge struct {
-	Node *User
+	Node   *User
+	cursor Cursor
 }
 
-func (*UserEdge) Cursor() *Cursor {
-	return nil
+func (e *UserEdge) Cursor() Cursor {
+	return e.cursor
 }
 
 type User struct {
@@ -165,6 +219,14 @@ func (u *User) Signup() *graphql.Time {
 	return &graphql.Time{Time: *u.User.Signup}
 }
 
+func (u *User) Location() *UserLocation {
+	if u.User.Location == nil {
+		return nil
+	}
+
+	return &UserLocation{*u.User.Location}
+}
+
 func (u *User) Twitch() *Link {
 	return wrapLink(u.User.Twitch)
 }
@@ -193,8 +255,14 @@ func (u *User) Runs(ctx context.Context, args FetchRunsArgs) (*RunConnection, er
 	return fetchRunConnection(ctx, u.client, args, speedrun.WithFilter("user", u.User.ID))
 }
 
-func (u *User) PersonalBests(ctx context.Context) ([]*PlacedRun, error) {
-	bests, err := u.client.ListUserPersonalBests(ctx, u.User.ID)
+func (u *User) PersonalBests(ctx context.Context, args struct {
+	Top    *int32      `filter:"top"`
+	Game   *graphql.ID `filter:"game"`
+	Series *graphql.ID `filter:"series"`
+	Embed  *[]RunEmbed
+}) ([]*PlacedRun, error) {
+	embeds := withEmbeds(args.Embed, planRunEmbeds(selectionFromContext(ctx).field("run")))
+	bests, err := u.client.ListUserPersonalBests(ctx, u.User.ID, speedrun.WithFilters(args), embeds)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +282,57 @@ func (u *User) ModeratedGames(ctx context.Context, args FetchGamesArgs) (*GameCo
 	return fetchGameConnection(ctx, u.client, args, speedrun.WithFilter("moderator", u.User.ID))
 }
 
+type UserLocation struct {
+	speedrun.UserLocation
+}
+
+func (l *UserLocation) Country() *Location {
+	return wrapLocation(l.UserLocation.Country)
+}
+
+func (l *UserLocation) Region() *Location {
+	return wrapLocation(l.UserLocation.Region)
+}
+
+type Location struct {
+	speedrun.Location
+}
+
+func (l *Location) Name(args struct {
+	Variant string
+}) *string {
+	var s string
+
+	switch args.Variant {
+	case "INTERNATIONAL":
+		s = l.Names.International
+	case "JAPANESE":
+		s = l.Names.Japanese
+	}
+
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func wrapLocation(loc *speedrun.Location) *Location {
+	if loc == nil {
+		return nil
+	}
+
+	return &Location{*loc}
+}
+
+// inCountry reports whether user has said they're from the country with the
+// given code.
+func inCountry(user *speedrun.User, country string) bool {
+	if user.Location == nil || user.Location.Country == nil {
+		return false
+	}
+	return strings.EqualFold(user.Location.Country.Code, country)
+}
+
 type UserNames struct {
 	speedrun.UserNames
 }
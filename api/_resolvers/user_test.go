@@ -0,0 +1,58 @@
+package resolvers
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestUserPersonalBests(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name string
+		user string
+		args map[string]interface{}
+		want []string
+	}{
+		{"all", "u1", nil, []string{"1:r1", "1:o1"}},
+		{"game", "u1", map[string]interface{}{"game": relay.MarshalID("game", "oot")}, []string{"1:o1"}},
+		{"series", "u1", map[string]interface{}{"series": relay.MarshalID("series", "mario")}, []string{"1:r1"}},
+		{"game and series", "u1", map[string]interface{}{"game": relay.MarshalID("game", "oot"), "series": relay.MarshalID("series", "mario")}, nil},
+		{"top", "u8", map[string]interface{}{"top": 8}, []string{"8:r8"}},
+		{"below top", "u8", map[string]interface{}{"top": 5}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars := map[string]interface{}{"user": relay.MarshalID("user", tt.user)}
+			for k, v := range tt.args {
+				vars[k] = v
+			}
+
+			var resp struct {
+				Node struct {
+					PersonalBests []struct {
+						Place int
+						Run   struct{ RawID string }
+					}
+				}
+			}
+			s.query(t, `query($user: ID!, $top: Int, $game: ID, $series: ID) {
+				node(id: $user) {
+					... on User { personalBests(top: $top, game: $game, series: $series) { place run { rawID } } }
+				}
+			}`, vars, &resp)
+
+			var got []string
+			for _, pb := range resp.Node.PersonalBests {
+				got = append(got, fmt.Sprintf("%d:%s", pb.Place, pb.Run.RawID))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("personal bests = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -63,6 +63,81 @@ func (v *Variable) Scope() VariableScopeType {
 	return VariableScopeType(v.Variable.Scope.Type)
 }
 
+func (v *Variable) Level(ctx context.Context) (*Level, error) {
+	if v.Variable.Scope.Type != speedrun.ScopeSingleLevel || v.Variable.Scope.LevelID == "" {
+		return nil, nil
+	}
+
+	l, err := v.client.GetLevel(ctx, v.Variable.Scope.LevelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return nil, nil
+	}
+
+	return &Level{*l, v.client}, nil
+}
+
+// AppliesTo reports whether runs in the category and level (or the full game,
+// if no level is given) should have a value for the variable. Variables never
+// apply to categories of other games, to per-level categories without a level,
+// or to per-game categories with one.
+func (v *Variable) AppliesTo(ctx context.Context, args struct {
+	Category graphql.ID
+	Level    *graphql.ID
+}) (bool, error) {
+	var categoryID string
+	if err := relay.UnmarshalSpec(args.Category, &categoryID); err != nil {
+		return false, err
+	}
+	var levelID string
+	if args.Level != nil {
+		if err := relay.UnmarshalSpec(*args.Level, &levelID); err != nil {
+			return false, err
+		}
+	}
+
+	if v.CategoryID != "" && v.CategoryID != categoryID {
+		return false, nil
+	}
+
+	c, err := v.client.GetCategory(ctx, categoryID)
+	if err != nil {
+		return false, err
+	}
+	if c == nil {
+		return false, nil
+	}
+	if v.CategoryID == "" && speedrun.FindLink(c.Links, "game") != speedrun.FindLink(v.Links, "game") {
+		return false, nil
+	}
+	switch c.Type {
+	case speedrun.CategoryPerGame:
+		if levelID != "" {
+			return false, nil
+		}
+	case speedrun.CategoryPerLevel:
+		if levelID == "" {
+			return false, nil
+		}
+	}
+
+	switch v.Variable.Scope.Type {
+	case speedrun.ScopeGlobal:
+		return true, nil
+	case speedrun.ScopeFullGame:
+		return levelID == "", nil
+	case speedrun.ScopeAllLevels:
+		return levelID != "", nil
+	case speedrun.ScopeSingleLevel:
+		return levelID != "" && levelID == v.Variable.Scope.LevelID, nil
+	default:
+		return false, nil
+	}
+}
+
 func (v *Variable) Values() []*VariableValue {
 	var vals []*VariableValue
 	for valID, val := range v.Variable.Values.Values {
@@ -0,0 +1,66 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/mjm/graphql-go/relay"
+)
+
+func TestVariableAppliesTo(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp struct {
+		Game struct {
+			Variables []struct {
+				RawID                          string
+				Cat120, Cat16, Cat120Level     bool
+				OtherGame, MissingCategory, IL bool
+				ILLevel, OtherGameLevel        bool
+			}
+		}
+	}
+	s.query(t, `query($game: ID!, $c120: ID!, $c16: ID!, $il: ID!, $any: ID!, $missing: ID!, $bob: ID!) {
+		game(id: $game) {
+			variables {
+				rawID
+				cat120: appliesTo(category: $c120)
+				cat16: appliesTo(category: $c16)
+				cat120Level: appliesTo(category: $c120, level: $bob)
+				otherGame: appliesTo(category: $any)
+				missingCategory: appliesTo(category: $missing)
+				il: appliesTo(category: $il)
+				ilLevel: appliesTo(category: $il, level: $bob)
+				otherGameLevel: appliesTo(category: $any, level: $bob)
+			}
+		}
+	}`, map[string]interface{}{
+		"game":    relay.MarshalID("game", "sm64"),
+		"c120":    relay.MarshalID("category", "120"),
+		"c16":     relay.MarshalID("category", "16"),
+		"il":      relay.MarshalID("category", "il"),
+		"any":     relay.MarshalID("category", "any"),
+		"missing": relay.MarshalID("category", "nope"),
+		"bob":     relay.MarshalID("level", "bob"),
+	}, &resp)
+
+	type applies struct {
+		cat120, cat16, cat120Level     bool
+		otherGame, missingCategory, il bool
+		ilLevel, otherGameLevel        bool
+	}
+	want := map[string]applies{
+		"ver":  {cat120: true},
+		"plat": {cat120: true, cat16: true, ilLevel: true},
+		"star": {ilLevel: true},
+	}
+
+	if len(resp.Game.Variables) != len(want) {
+		t.Fatalf("got %d variables, want %d", len(resp.Game.Variables), len(want))
+	}
+	for _, v := range resp.Game.Variables {
+		got := applies{v.Cat120, v.Cat16, v.Cat120Level, v.OtherGame, v.MissingCategory, v.IL, v.ILLevel, v.OtherGameLevel}
+		if got != want[v.RawID] {
+			t.Errorf("%s applies to %+v, want %+v", v.RawID, got, want[v.RawID])
+		}
+	}
+}
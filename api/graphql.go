@@ -5,9 +5,10 @@ import (
 	"net/http"
 
 	"github.com/mjm/graphql-go"
-	"github.com/mjm/graphql-go/relay"
+	"github.com/mjm/graphql-go/trace"
 
 	"github.com/mjm/speedrungql/api/_resolvers"
+	"github.com/mjm/speedrungql/speedrun"
 )
 
 var handler http.Handler
@@ -18,15 +19,16 @@ func init() {
 		panic(err)
 	}
 
-	resolve := resolvers.New("https://www.speedrun.com/api/v1")
+	resolve := resolvers.New(speedrun.NewClient("https://www.speedrun.com/api/v1"))
 
 	schema, err := graphql.ParseSchema(string(schemaData), resolve,
-		graphql.UseFieldResolvers())
+		graphql.UseFieldResolvers(),
+		graphql.Tracer(resolvers.SelectionTracer{Tracer: trace.OpenTracingTracer{}}))
 	if err != nil {
 		panic(err)
 	}
 
-	handler = &relay.Handler{Schema: schema}
+	handler = resolve.Handler(schema)
 }
 
 func Handler(w http.ResponseWriter, r *http.Request) {
@@ -1,31 +1,53 @@
 package main
 
 import (
+	"flag"
 	"io/ioutil"
 	"log"
 	"net/http"
 
 	"github.com/mjm/graphql-go"
-	"github.com/mjm/graphql-go/relay"
+	"github.com/mjm/graphql-go/trace"
 
 	"github.com/mjm/speedrungql/api/_resolvers"
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+var (
+	recordDir = flag.String("record", "", "record speedrun.com responses into this directory")
+	replayDir = flag.String("replay", "", "replay speedrun.com responses from this directory instead of using the network")
 )
 
 func main() {
+	flag.Parse()
+
 	schemaData, err := ioutil.ReadFile("api/schema.graphql")
 	if err != nil {
 		panic(err)
 	}
 
-	resolve := resolvers.New("https://www.speedrun.com/api/v1")
+	client := speedrun.NewClient("https://www.speedrun.com/api/v1")
+	switch {
+	case *recordDir != "" && *replayDir != "":
+		log.Fatal("cannot use -record and -replay together")
+	case *recordDir != "":
+		client.HTTPClient.Transport = &speedrun.RecordingTransport{Dir: *recordDir}
+	case *replayDir != "":
+		client.HTTPClient.Transport = &speedrun.ReplayTransport{Dir: *replayDir}
+		client.Cache = nil
+		client.RequestsPerMinute = 0
+	}
+
+	resolve := resolvers.New(client)
 
 	schema, err := graphql.ParseSchema(string(schemaData), resolve,
-		graphql.UseFieldResolvers())
+		graphql.UseFieldResolvers(),
+		graphql.Tracer(resolvers.SelectionTracer{Tracer: trace.OpenTracingTracer{}}))
 	if err != nil {
 		panic(err)
 	}
 
-	handler := &relay.Handler{Schema: schema}
+	handler := resolve.Handler(schema)
 	http.Handle("/graphql", handler)
 
 	log.Fatal(http.ListenAndServe(":8080", nil))
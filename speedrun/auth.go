@@ -0,0 +1,21 @@
+package speedrun
+
+import (
+	"context"
+)
+
+type apiKeyKey struct{}
+
+// WithAPIKey returns a copy of ctx that makes requests to speedrun.com on
+// behalf of the user the API key belongs to.
+//
+// Responses to authenticated requests can include things only that user is
+// allowed to see, so they are never stored in or read from the client's Cache.
+func WithAPIKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyKey{}, key)
+}
+
+func apiKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(apiKeyKey{}).(string)
+	return key
+}
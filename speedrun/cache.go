@@ -0,0 +1,178 @@
+package speedrun
+
+import (
+	"container/list"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultCacheSize = 1000
+
+// DefaultCacheTTLs are the TTLs used by NewCache for each kind of resource.
+// Kinds that are not listed use the cache's DefaultTTL.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"games":          time.Hour,
+	"platforms":      24 * time.Hour,
+	"regions":        24 * time.Hour,
+	"genres":         24 * time.Hour,
+	"engines":        24 * time.Hour,
+	"gametypes":      24 * time.Hour,
+	"developers":     24 * time.Hour,
+	"publishers":     24 * time.Hour,
+	"series":         time.Hour,
+	"categories":     time.Hour,
+	"levels":         time.Hour,
+	"variables":      time.Hour,
+	"users":          15 * time.Minute,
+	"guests":         15 * time.Minute,
+	"runs":           time.Minute,
+	"leaderboards":   time.Minute,
+	"records":        time.Minute,
+	"personal-bests": time.Minute,
+}
+
+// Cache is a size-bounded cache of speedrun.com responses shared by all
+// requests made with a Client.
+//
+// Responses are keyed by their full URL, and expire after a TTL chosen by the
+// kind of resource they contain. Once the cache holds MaxEntries responses,
+// the least recently used ones are evicted.
+type Cache struct {
+	MaxEntries int
+	TTLs       map[string]time.Duration
+	DefaultTTL time.Duration
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key     string
+	data    []byte
+	expires time.Time
+}
+
+func NewCache() *Cache {
+	ttls := make(map[string]time.Duration, len(DefaultCacheTTLs))
+	for kind, ttl := range DefaultCacheTTLs {
+		ttls[kind] = ttl
+	}
+
+	return &Cache{
+		MaxEntries: DefaultCacheSize,
+		TTLs:       ttls,
+		DefaultTTL: time.Minute,
+	}
+}
+
+func (c *Cache) get(key string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		c.removeElement(el)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return entry.data, true
+}
+
+func (c *Cache) add(key string, kind string, data []byte) {
+	if c == nil {
+		return
+	}
+
+	ttl, ok := c.TTLs[kind]
+	if !ok {
+		ttl = c.DefaultTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.items == nil {
+		c.ll = list.New()
+		c.items = make(map[string]*list.Element)
+	}
+
+	expires := time.Now().Add(ttl)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*cacheEntry)
+		entry.data = data
+		entry.expires = expires
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&cacheEntry{
+		key:     key,
+		data:    data,
+		expires: expires,
+	})
+
+	for c.MaxEntries > 0 && c.ll.Len() > c.MaxEntries {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Clear removes all responses from the cache.
+func (c *Cache) Clear() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll = nil
+	c.items = nil
+}
+
+func (c *Cache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*cacheEntry).key)
+}
+
+// resourceKind determines which kind of resource the URL u refers to, so that
+// the right TTL can be used when caching it. For nested collections like
+// /games/{id}/categories, the innermost collection wins.
+func (c *Client) resourceKind(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return ""
+	}
+
+	// The escaped path keeps IDs like guest names that contain a slash in a
+	// single segment.
+	path := parsed.EscapedPath()
+	if base, err := url.Parse(c.BaseURL); err == nil {
+		path = strings.TrimPrefix(path, base.EscapedPath())
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] == "leaderboards" {
+		return segments[0]
+	}
+
+	if len(segments)%2 == 0 {
+		return segments[len(segments)-2]
+	}
+	return segments[len(segments)-1]
+}
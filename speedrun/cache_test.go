@@ -0,0 +1,126 @@
+package speedrun
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCacheTTLs(t *testing.T) {
+	c := NewCache()
+
+	for kind, ttl := range DefaultCacheTTLs {
+		key := "https://example.com/" + kind
+		before := time.Now()
+		c.add(key, kind, []byte(kind))
+
+		expires := c.items[key].Value.(*cacheEntry).expires
+		if expires.Before(before.Add(ttl)) || expires.After(time.Now().Add(ttl)) {
+			t.Errorf("%s expires at %v, want %v after it was added", kind, expires, ttl)
+		}
+	}
+
+	c.add("https://example.com/unknown", "unknown", []byte("unknown"))
+	expires := c.items["https://example.com/unknown"].Value.(*cacheEntry).expires
+	if time.Until(expires) > c.DefaultTTL {
+		t.Errorf("unknown kind expires in %v, want at most %v", time.Until(expires), c.DefaultTTL)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	c := NewCache()
+	c.add("a", "runs", []byte("a"))
+
+	if data, ok := c.get("a"); !ok || string(data) != "a" {
+		t.Fatalf("get(a) = %q, %v before expiring", data, ok)
+	}
+
+	c.items["a"].Value.(*cacheEntry).expires = time.Now().Add(-time.Second)
+	if _, ok := c.get("a"); ok {
+		t.Errorf("get(a) found an expired entry")
+	}
+	if _, ok := c.items["a"]; ok {
+		t.Errorf("expired entry was not removed")
+	}
+}
+
+func TestCacheZeroTTL(t *testing.T) {
+	c := NewCache()
+	c.TTLs["runs"] = 0
+
+	c.add("a", "runs", []byte("a"))
+	if _, ok := c.get("a"); ok {
+		t.Errorf("cached a response with a zero TTL")
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	c := NewCache()
+	c.MaxEntries = 3
+
+	for i := 0; i < 3; i++ {
+		c.add(fmt.Sprint(i), "games", []byte{byte(i)})
+	}
+
+	// Using 0 makes 1 the least recently used entry.
+	c.get("0")
+	c.add("3", "games", []byte{3})
+
+	for key, want := range map[string]bool{"0": true, "1": false, "2": true, "3": true} {
+		if _, ok := c.get(key); ok != want {
+			t.Errorf("get(%s) found = %v, want %v", key, ok, want)
+		}
+	}
+	if c.ll.Len() != 3 {
+		t.Errorf("cache has %d entries, want 3", c.ll.Len())
+	}
+}
+
+func TestCacheClear(t *testing.T) {
+	c := NewCache()
+	c.add("a", "games", []byte("a"))
+	c.Clear()
+
+	if _, ok := c.get("a"); ok {
+		t.Errorf("get(a) found an entry after clearing")
+	}
+
+	c.add("b", "games", []byte("b"))
+	if _, ok := c.get("b"); !ok {
+		t.Errorf("get(b) missed after adding to a cleared cache")
+	}
+
+	var nilCache *Cache
+	nilCache.Clear()
+	nilCache.add("a", "games", []byte("a"))
+	if _, ok := nilCache.get("a"); ok {
+		t.Errorf("nil cache found an entry")
+	}
+}
+
+func TestResourceKind(t *testing.T) {
+	c := NewClient("https://www.speedrun.com/api/v1")
+
+	tests := []struct {
+		u    string
+		want string
+	}{
+		{"https://www.speedrun.com/api/v1/games", "games"},
+		{"https://www.speedrun.com/api/v1/games?name=mario&max=20", "games"},
+		{"https://www.speedrun.com/api/v1/games/sm64", "games"},
+		{"https://www.speedrun.com/api/v1/games/sm64/categories", "categories"},
+		{"https://www.speedrun.com/api/v1/games/sm64/records", "records"},
+		{"https://www.speedrun.com/api/v1/users/u1/personal-bests", "personal-bests"},
+		{"https://www.speedrun.com/api/v1/leaderboards/sm64/category/120", "leaderboards"},
+		{"https://www.speedrun.com/api/v1/leaderboards/sm64/level/bob/120", "leaderboards"},
+		{"https://www.speedrun.com/api/v1/guests/Guesty", "guests"},
+		{c.guestKey("a/b"), "guests"},
+		{c.guestKey("a/b/c"), "guests"},
+	}
+
+	for _, tt := range tests {
+		if got := c.resourceKind(tt.u); got != tt.want {
+			t.Errorf("resourceKind(%q) = %q, want %q", tt.u, got, tt.want)
+		}
+	}
+}
@@ -2,22 +2,51 @@ package speedrun
 
 import (
 	"net/http"
-
-	"github.com/graph-gophers/dataloader"
+	"sync"
+	"time"
 )
 
 type Client struct {
 	HTTPClient *http.Client
 	BaseURL    string
 
-	loader *dataloader.Loader
+	// Cache holds responses across requests. If nil, every request that isn't
+	// already loaded in the current context goes to speedrun.com.
+	Cache *Cache
+
+	// RequestsPerMinute limits how many requests the client sends to
+	// speedrun.com, across all goroutines. Zero means no limit.
+	RequestsPerMinute int
+
+	// MaxConcurrentRequests limits how many requests may be in flight at once.
+	// Zero means no limit.
+	MaxConcurrentRequests int
+
+	// MaxRetries is how many times a request is retried after being throttled
+	// or failing with a server error. Retries wait for RetryBackoff, doubling
+	// with each attempt, unless the response asks for a specific delay.
+	MaxRetries   int
+	RetryBackoff time.Duration
+
+	// MaxRetryDelay is the longest the client will wait before retrying a
+	// request. If speedrun.com asks for a longer wait, the request fails
+	// instead. Zero means no limit.
+	MaxRetryDelay time.Duration
+
+	limiterOnce sync.Once
+	limiter     *limiter
 }
 
 func NewClient(baseURL string) *Client {
-	c := &Client{
+	return &Client{
 		BaseURL:    baseURL,
 		HTTPClient: &http.Client{},
+		Cache:      NewCache(),
+
+		RequestsPerMinute:     100,
+		MaxConcurrentRequests: 10,
+		MaxRetries:            3,
+		RetryBackoff:          500 * time.Millisecond,
+		MaxRetryDelay:         10 * time.Second,
 	}
-	c.loader = c.newLoader()
-	return c
 }
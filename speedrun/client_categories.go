@@ -2,6 +2,7 @@ package speedrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -27,6 +28,9 @@ func (c *Client) ListLevelCategories(ctx context.Context, levelID string, opts .
 func (c *Client) GetCategory(ctx context.Context, categoryID string) (*Category, error) {
 	var category Category
 	if err := c.loadItem(ctx, c.categoryKey(categoryID), &category); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &category, nil
@@ -0,0 +1,61 @@
+package speedrun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func (c *Client) ListDevelopers(ctx context.Context, opts ...FetchOption) ([]*Developer, *PageInfo, error) {
+	var resp DevelopersResponse
+	if err := c.fetch(ctx, "/developers", &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) GetDeveloper(ctx context.Context, developerID string) (*Developer, error) {
+	var developer Developer
+	if err := c.loadItem(ctx, c.developerKey(developerID), &developer); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &developer, nil
+}
+
+func (c *Client) GetDevelopers(ctx context.Context, ids []string) ([]*Developer, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var paths []string
+	for _, id := range ids {
+		paths = append(paths, c.developerKey(id))
+	}
+
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var developers []*Developer
+	for _, data := range items {
+		var developer Developer
+		if err := json.Unmarshal(data, &developer); err != nil {
+			return nil, err
+		}
+		developers = append(developers, &developer)
+	}
+	return developers, nil
+}
+
+func (c *Client) developerKey(id string) string {
+	if strings.HasPrefix(id, c.BaseURL) {
+		return id
+	}
+	return fmt.Sprintf("%s/developers/%s", c.BaseURL, id)
+}
@@ -3,15 +3,17 @@ package speedrun
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/graph-gophers/dataloader"
 )
 
 func (c *Client) GetEngine(ctx context.Context, engineID string) (*Engine, error) {
 	var category Engine
 	if err := c.loadItem(ctx, c.engineKey(engineID), &category); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &category, nil
@@ -22,20 +24,20 @@ func (c *Client) GetEngines(ctx context.Context, ids []string) ([]*Engine, error
 		return nil, nil
 	}
 
-	var keys dataloader.Keys
+	var paths []string
 	for _, id := range ids {
-		keys = append(keys, dataloader.StringKey(c.engineKey(id)))
+		paths = append(paths, c.engineKey(id))
 	}
 
-	ress, errs := c.loader.LoadMany(ctx, keys)()
-	if len(errs) > 0 {
-		return nil, errs[0]
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
 	}
 
 	var engines []*Engine
-	for _, res := range ress {
+	for _, data := range items {
 		var engine Engine
-		if err := json.Unmarshal(res.(*EnvelopeResponse).Data, &engine); err != nil {
+		if err := json.Unmarshal(data, &engine); err != nil {
 			return nil, err
 		}
 		engines = append(engines, &engine)
@@ -2,6 +2,7 @@ package speedrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -18,11 +19,23 @@ func (c *Client) ListGames(ctx context.Context, opts ...FetchOption) ([]*Game, *
 func (c *Client) GetGame(ctx context.Context, gameID string) (*Game, error) {
 	var game Game
 	if err := c.loadItem(ctx, c.gameKey(gameID), &game); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &game, nil
 }
 
+func (c *Client) ListDerivedGames(ctx context.Context, gameID string, opts ...FetchOption) ([]*Game, *PageInfo, error) {
+	var resp GamesResponse
+	if err := c.fetch(ctx, fmt.Sprintf("/games/%s/derived-games", gameID), &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Data, resp.Pagination, nil
+}
+
 func (c *Client) gameKey(id string) string {
 	if strings.HasPrefix(id, c.BaseURL) {
 		return id
@@ -0,0 +1,61 @@
+package speedrun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func (c *Client) ListGameTypes(ctx context.Context, opts ...FetchOption) ([]*GameType, *PageInfo, error) {
+	var resp GameTypesResponse
+	if err := c.fetch(ctx, "/gametypes", &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) GetGameType(ctx context.Context, gameTypeID string) (*GameType, error) {
+	var gameType GameType
+	if err := c.loadItem(ctx, c.gameTypeKey(gameTypeID), &gameType); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &gameType, nil
+}
+
+func (c *Client) GetGameTypes(ctx context.Context, ids []string) ([]*GameType, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var paths []string
+	for _, id := range ids {
+		paths = append(paths, c.gameTypeKey(id))
+	}
+
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var gameTypes []*GameType
+	for _, data := range items {
+		var gameType GameType
+		if err := json.Unmarshal(data, &gameType); err != nil {
+			return nil, err
+		}
+		gameTypes = append(gameTypes, &gameType)
+	}
+	return gameTypes, nil
+}
+
+func (c *Client) gameTypeKey(id string) string {
+	if strings.HasPrefix(id, c.BaseURL) {
+		return id
+	}
+	return fmt.Sprintf("%s/gametypes/%s", c.BaseURL, id)
+}
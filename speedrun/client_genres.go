@@ -3,10 +3,9 @@ package speedrun
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/graph-gophers/dataloader"
 )
 
 func (c *Client) ListGenres(ctx context.Context, opts ...FetchOption) ([]*Genre, *PageInfo, error) {
@@ -20,6 +19,9 @@ func (c *Client) ListGenres(ctx context.Context, opts ...FetchOption) ([]*Genre,
 func (c *Client) GetGenre(ctx context.Context, genreID string) (*Genre, error) {
 	var genre Genre
 	if err := c.loadItem(ctx, c.genreKey(genreID), &genre); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &genre, nil
@@ -30,20 +32,20 @@ func (c *Client) GetGenres(ctx context.Context, ids []string) ([]*Genre, error)
 		return nil, nil
 	}
 
-	var keys dataloader.Keys
+	var paths []string
 	for _, id := range ids {
-		keys = append(keys, dataloader.StringKey(c.genreKey(id)))
+		paths = append(paths, c.genreKey(id))
 	}
 
-	ress, errs := c.loader.LoadMany(ctx, keys)()
-	if len(errs) > 0 {
-		return nil, errs[0]
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
 	}
 
 	var genres []*Genre
-	for _, res := range ress {
+	for _, data := range items {
 		var genre Genre
-		if err := json.Unmarshal(res.(*EnvelopeResponse).Data, &genre); err != nil {
+		if err := json.Unmarshal(data, &genre); err != nil {
 			return nil, err
 		}
 		genres = append(genres, &genre)
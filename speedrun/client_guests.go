@@ -0,0 +1,27 @@
+package speedrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetGuest(ctx context.Context, name string) (*Guest, error) {
+	var guest Guest
+	if err := c.loadItem(ctx, c.guestKey(name), &guest); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (c *Client) guestKey(name string) string {
+	if strings.HasPrefix(name, c.BaseURL) {
+		return name
+	}
+	return fmt.Sprintf("%s/guests/%s", c.BaseURL, url.PathEscape(name))
+}
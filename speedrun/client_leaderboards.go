@@ -18,5 +18,36 @@ func (c *Client) GetLeaderboard(ctx context.Context, gameID string, categoryID s
 		return nil, err
 	}
 
+	c.primeLeaderboards(ctx, []*Leaderboard{resp.Data})
 	return resp.Data, nil
 }
+
+func (c *Client) ListGameRecords(ctx context.Context, gameID string, opts ...FetchOption) ([]*Leaderboard, *PageInfo, error) {
+	var resp LeaderboardsResponse
+	if err := c.fetch(ctx, fmt.Sprintf("/games/%s/records", gameID), &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+
+	c.primeLeaderboards(ctx, resp.Data)
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) ListCategoryRecords(ctx context.Context, categoryID string, opts ...FetchOption) ([]*Leaderboard, *PageInfo, error) {
+	var resp LeaderboardsResponse
+	if err := c.fetch(ctx, fmt.Sprintf("/categories/%s/records", categoryID), &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+
+	c.primeLeaderboards(ctx, resp.Data)
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) ListLevelRecords(ctx context.Context, levelID string, opts ...FetchOption) ([]*Leaderboard, *PageInfo, error) {
+	var resp LeaderboardsResponse
+	if err := c.fetch(ctx, fmt.Sprintf("/levels/%s/records", levelID), &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+
+	c.primeLeaderboards(ctx, resp.Data)
+	return resp.Data, resp.Pagination, nil
+}
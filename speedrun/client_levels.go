@@ -2,6 +2,7 @@ package speedrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -18,6 +19,9 @@ func (c *Client) ListGameLevels(ctx context.Context, gameID string) ([]*Level, e
 func (c *Client) GetLevel(ctx context.Context, levelID string) (*Level, error) {
 	var level Level
 	if err := c.loadItem(ctx, c.levelKey(levelID), &level); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &level, nil
@@ -0,0 +1,15 @@
+package speedrun
+
+import (
+	"context"
+)
+
+// ListNotifications lists the notifications of the user whose API key is in
+// ctx.
+func (c *Client) ListNotifications(ctx context.Context, opts ...FetchOption) ([]*Notification, *PageInfo, error) {
+	var resp NotificationsResponse
+	if err := c.fetch(ctx, "/notifications", &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, resp.Pagination, nil
+}
@@ -3,10 +3,9 @@ package speedrun
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/graph-gophers/dataloader"
 )
 
 func (c *Client) ListPlatforms(ctx context.Context, opts ...FetchOption) ([]*Platform, *PageInfo, error) {
@@ -21,6 +20,9 @@ func (c *Client) ListPlatforms(ctx context.Context, opts ...FetchOption) ([]*Pla
 func (c *Client) GetPlatform(ctx context.Context, id string) (*Platform, error) {
 	var platform Platform
 	if err := c.loadItem(ctx, c.platformKey(id), &platform); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &platform, nil
@@ -31,20 +33,20 @@ func (c *Client) GetPlatforms(ctx context.Context, ids []string) ([]*Platform, e
 		return nil, nil
 	}
 
-	var keys dataloader.Keys
+	var paths []string
 	for _, id := range ids {
-		keys = append(keys, dataloader.StringKey(c.platformKey(id)))
+		paths = append(paths, c.platformKey(id))
 	}
 
-	ress, errs := c.loader.LoadMany(ctx, keys)()
-	if len(errs) > 0 {
-		return nil, errs[0]
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
 	}
 
 	var platforms []*Platform
-	for _, res := range ress {
+	for _, data := range items {
 		var platform Platform
-		if err := json.Unmarshal(res.(*EnvelopeResponse).Data, &platform); err != nil {
+		if err := json.Unmarshal(data, &platform); err != nil {
 			return nil, err
 		}
 		platforms = append(platforms, &platform)
@@ -0,0 +1,61 @@
+package speedrun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func (c *Client) ListPublishers(ctx context.Context, opts ...FetchOption) ([]*Publisher, *PageInfo, error) {
+	var resp PublishersResponse
+	if err := c.fetch(ctx, "/publishers", &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) GetPublisher(ctx context.Context, publisherID string) (*Publisher, error) {
+	var publisher Publisher
+	if err := c.loadItem(ctx, c.publisherKey(publisherID), &publisher); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &publisher, nil
+}
+
+func (c *Client) GetPublishers(ctx context.Context, ids []string) ([]*Publisher, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var paths []string
+	for _, id := range ids {
+		paths = append(paths, c.publisherKey(id))
+	}
+
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var publishers []*Publisher
+	for _, data := range items {
+		var publisher Publisher
+		if err := json.Unmarshal(data, &publisher); err != nil {
+			return nil, err
+		}
+		publishers = append(publishers, &publisher)
+	}
+	return publishers, nil
+}
+
+func (c *Client) publisherKey(id string) string {
+	if strings.HasPrefix(id, c.BaseURL) {
+		return id
+	}
+	return fmt.Sprintf("%s/publishers/%s", c.BaseURL, id)
+}
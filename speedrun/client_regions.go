@@ -3,15 +3,17 @@ package speedrun
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/graph-gophers/dataloader"
 )
 
 func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error) {
 	var region Region
 	if err := c.loadItem(ctx, c.regionKey(regionID), &region); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &region, nil
@@ -22,20 +24,20 @@ func (c *Client) GetRegions(ctx context.Context, ids []string) ([]*Region, error
 		return nil, nil
 	}
 
-	var keys dataloader.Keys
+	var paths []string
 	for _, id := range ids {
-		keys = append(keys, dataloader.StringKey(c.regionKey(id)))
+		paths = append(paths, c.regionKey(id))
 	}
 
-	ress, errs := c.loader.LoadMany(ctx, keys)()
-	if len(errs) > 0 {
-		return nil, errs[0]
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
 	}
 
 	var regions []*Region
-	for _, res := range ress {
+	for _, data := range items {
 		var region Region
-		if err := json.Unmarshal(res.(*EnvelopeResponse).Data, &region); err != nil {
+		if err := json.Unmarshal(data, &region); err != nil {
 			return nil, err
 		}
 		regions = append(regions, &region)
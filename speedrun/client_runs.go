@@ -2,6 +2,7 @@ package speedrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -11,12 +12,16 @@ func (c *Client) ListRuns(ctx context.Context, opts ...FetchOption) ([]*Run, *Pa
 	if err := c.fetch(ctx, "/runs", &resp, opts...); err != nil {
 		return nil, nil, err
 	}
+	c.primeRuns(ctx, resp.Data)
 	return resp.Data, resp.Pagination, nil
 }
 
 func (c *Client) GetRun(ctx context.Context, runID string) (*Run, error) {
 	var run Run
 	if err := c.loadItem(ctx, c.runKey(runID), &run); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &run, nil
@@ -29,10 +34,11 @@ func (c *Client) runKey(id string) string {
 	return fmt.Sprintf("%s/runs/%s", c.BaseURL, id)
 }
 
-func (c *Client) ListUserPersonalBests(ctx context.Context, userID string) ([]PlacedRun, error) {
+func (c *Client) ListUserPersonalBests(ctx context.Context, userID string, opts ...FetchOption) ([]PlacedRun, error) {
 	var resp PlacedRunsResponse
-	if err := c.fetch(ctx, fmt.Sprintf("/users/%s/personal-bests", userID), &resp); err != nil {
+	if err := c.fetch(ctx, fmt.Sprintf("/users/%s/personal-bests", userID), &resp, opts...); err != nil {
 		return nil, err
 	}
+	c.primePlacedRuns(ctx, resp.Data)
 	return resp.Data, nil
 }
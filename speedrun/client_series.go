@@ -0,0 +1,42 @@
+package speedrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func (c *Client) ListSeries(ctx context.Context, opts ...FetchOption) ([]*Series, *PageInfo, error) {
+	var resp SeriesResponse
+	if err := c.fetch(ctx, "/series", &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) GetSeries(ctx context.Context, seriesID string) (*Series, error) {
+	var series Series
+	if err := c.loadItem(ctx, c.seriesKey(seriesID), &series); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (c *Client) ListSeriesGames(ctx context.Context, seriesID string, opts ...FetchOption) ([]*Game, *PageInfo, error) {
+	var resp GamesResponse
+	if err := c.fetch(ctx, fmt.Sprintf("/series/%s/games", seriesID), &resp, opts...); err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, resp.Pagination, nil
+}
+
+func (c *Client) seriesKey(id string) string {
+	if strings.HasPrefix(id, c.BaseURL) {
+		return id
+	}
+	return fmt.Sprintf("%s/series/%s", c.BaseURL, id)
+}
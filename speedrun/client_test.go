@@ -0,0 +1,204 @@
+package speedrun_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mjm/speedrungql/speedrun"
+	"github.com/mjm/speedrungql/speedrun/speedruntest"
+)
+
+func newTestServer(t *testing.T) *speedruntest.Server {
+	d := &speedruntest.Dataset{
+		Games: []*speedrun.Game{
+			{ID: "sm64", Names: speedrun.GameNames{International: "Super Mario 64"}},
+		},
+		Categories: []*speedrun.Category{
+			{ID: "120", Name: "120 Star", Links: []speedrun.Link{{Rel: "game", URI: "/games/sm64"}}},
+		},
+		Users: []*speedrun.User{
+			{ID: "u1", Names: speedrun.UserNames{International: "Alice"}},
+		},
+		Runs: []*speedrun.Run{
+			{ID: "r1", GameID: "sm64", CategoryID: "120", Status: speedrun.RunStatus{Status: speedrun.RunVerified}, Players: []speedrun.RunPlayer{{Rel: speedrun.PlayerUser, ID: "u1"}}, Times: speedrun.RunTimes{Primary: 6000}},
+		},
+	}
+	for i := 0; i < 30; i++ {
+		d.Games = append(d.Games, &speedrun.Game{ID: fmt.Sprintf("g%02d", i)})
+	}
+
+	s := speedruntest.NewServer(d)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestListGamesPaging(t *testing.T) {
+	s := newTestServer(t)
+	c := s.NewClient()
+	ctx := context.Background()
+
+	games, pageInfo, err := c.ListGames(ctx, speedrun.WithLimit(10), speedrun.WithOffset(25))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 6 || games[0].ID != "g25" {
+		t.Errorf("ListGames() returned %d games starting at %v, want 6 starting at g25", len(games), games[0].ID)
+	}
+	if pageInfo.Offset != 25 || pageInfo.Size != 6 || pageInfo.Max != 10 {
+		t.Errorf("page info = %+v, want offset 25, size 6 and max 10", pageInfo)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	s := newTestServer(t)
+	c := s.NewClient()
+
+	game, err := c.GetGame(context.Background(), "nope")
+	if err != nil || game != nil {
+		t.Errorf("GetGame(nope) = %v, %v, want nil, nil", game, err)
+	}
+}
+
+func TestListBadRequest(t *testing.T) {
+	s := newTestServer(t)
+	c := s.NewClient()
+
+	_, _, err := c.ListGames(context.Background(), speedrun.WithLimit(500))
+	var apiErr *speedrun.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 400 {
+		t.Errorf("ListGames() with max 500 = %v, want a 400 APIError", err)
+	}
+}
+
+func TestEmbedsPrimeLoader(t *testing.T) {
+	s := newTestServer(t)
+	c := s.NewClient()
+	ctx := c.WithLoader(context.Background())
+
+	runs, _, err := c.ListRuns(ctx, speedrun.WithEmbed(speedrun.EmbedGame, speedrun.EmbedCategory, speedrun.EmbedPlayers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].GameID != "sm64" {
+		t.Fatalf("ListRuns() = %v, want r1 with its game ID", runs)
+	}
+
+	before := s.Requests()
+	game, err := c.GetGame(ctx, "sm64")
+	if err != nil || game == nil || game.Names.International != "Super Mario 64" {
+		t.Errorf("GetGame(sm64) = %v, %v", game, err)
+	}
+	category, err := c.GetCategory(ctx, "120")
+	if err != nil || category == nil || category.Name != "120 Star" {
+		t.Errorf("GetCategory(120) = %v, %v", category, err)
+	}
+	user, err := c.GetUser(ctx, "u1")
+	if err != nil || user == nil || user.Names.International != "Alice" {
+		t.Errorf("GetUser(u1) = %v, %v", user, err)
+	}
+	if n := s.Requests() - before; n != 0 {
+		t.Errorf("loading embedded resources made %d requests, want 0", n)
+	}
+
+	if _, err := c.GetGame(ctx, "g00"); err != nil {
+		t.Fatal(err)
+	}
+	if n := s.Requests() - before; n != 1 {
+		t.Errorf("loading a game that wasn't embedded made %d requests, want 1", n)
+	}
+}
+
+func TestLeaderboardPlayers(t *testing.T) {
+	s := newTestServer(t)
+	c := s.NewClient()
+	ctx := context.Background()
+
+	lb, err := c.GetLeaderboard(ctx, "sm64", "120", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if players, err := lb.Players(); err != nil || players != nil {
+		t.Errorf("Players() without embedding = %v, %v, want nil", players, err)
+	}
+
+	lb, err = c.GetLeaderboard(ctx, "sm64", "120", nil, speedrun.WithEmbed(speedrun.EmbedPlayers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	players, err := lb.Players()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(players) != 1 || players["u1"] == nil || players["u1"].Names.International != "Alice" {
+		t.Errorf("Players() = %v, want Alice", players)
+	}
+}
+
+func TestCacheWithAPIKey(t *testing.T) {
+	s := newTestServer(t)
+	s.Update(func(d *speedruntest.Dataset) {
+		d.Users = append(d.Users, &speedrun.User{ID: "u2", Names: speedrun.UserNames{International: "Bob"}})
+		d.APIKeys = map[string]string{"alice": "u1", "bob": "u2"}
+		d.Notifications = map[string][]*speedrun.Notification{
+			"u1": {{ID: "n1", Text: "For Alice"}},
+			"u2": {{ID: "n2", Text: "For Bob"}},
+		}
+	})
+	c := s.NewClient()
+	c.Cache = speedrun.NewCache()
+
+	// Each request gets its own loader, so only the cache can save requests.
+	request := func(key string) context.Context {
+		ctx := c.WithLoader(context.Background())
+		if key != "" {
+			ctx = speedrun.WithAPIKey(ctx, key)
+		}
+		return ctx
+	}
+
+	// Rename the game between requests, so each response shows whether it
+	// came from the cache.
+	getGame := func(key, name string) string {
+		s.Update(func(d *speedruntest.Dataset) {
+			d.Games[0].Names.International = name
+		})
+		game, err := c.GetGame(request(key), "sm64")
+		if err != nil || game == nil {
+			t.Fatalf("GetGame() with key %q = %v, %v", key, game, err)
+		}
+		return game.Names.International
+	}
+	for _, tt := range []struct{ key, name, want string }{
+		{"alice", "A", "A"},
+		{"", "B", "B"},
+		{"alice", "C", "C"},
+		{"", "D", "B"},
+		{"bob", "E", "E"},
+	} {
+		if got := getGame(tt.key, tt.name); got != tt.want {
+			t.Errorf("GetGame() with key %q = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+
+	before := s.Requests()
+	for _, tt := range []struct{ key, user, notification string }{
+		{"alice", "Alice", "For Alice"},
+		{"bob", "Bob", "For Bob"},
+		{"alice", "Alice", "For Alice"},
+	} {
+		user, err := c.GetProfile(request(tt.key))
+		if err != nil || user == nil || user.Names.International != tt.user {
+			t.Errorf("GetProfile() with key %q = %v, %v, want %s", tt.key, user, err, tt.user)
+		}
+
+		notifications, _, err := c.ListNotifications(request(tt.key))
+		if err != nil || len(notifications) != 1 || notifications[0].Text != tt.notification {
+			t.Errorf("ListNotifications() with key %q = %v, %v, want %q", tt.key, notifications, err, tt.notification)
+		}
+	}
+	if n := s.Requests() - before; n != 6 {
+		t.Errorf("loading profiles and notifications made %d requests, want 6", n)
+	}
+}
@@ -2,6 +2,8 @@ package speedrun
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -17,11 +19,56 @@ func (c *Client) ListUsers(ctx context.Context, opts ...FetchOption) ([]*User, *
 func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
 	var user User
 	if err := c.loadItem(ctx, c.userKey(userID), &user); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &user, nil
 }
 
+// GetProfile loads the user whose API key is in ctx, or returns nil if ctx
+// doesn't have one.
+func (c *Client) GetProfile(ctx context.Context) (*User, error) {
+	if apiKeyFromContext(ctx) == "" {
+		return nil, nil
+	}
+
+	var user User
+	if err := c.loadItem(ctx, c.BaseURL+"/profile", &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUsers loads the users with the given IDs, keyed by ID. Users that
+// speedrun.com doesn't have are left out.
+func (c *Client) GetUsers(ctx context.Context, ids []string) (map[string]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var paths []string
+	for _, id := range ids {
+		paths = append(paths, c.userKey(id))
+	}
+
+	items, err := c.loadItems(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[string]*User)
+	for _, data := range items {
+		var user User
+		if err := json.Unmarshal(data, &user); err != nil {
+			return nil, err
+		}
+		users[user.ID] = &user
+	}
+	return users, nil
+}
+
 func (c *Client) userKey(id string) string {
 	if strings.HasPrefix(id, c.BaseURL) {
 		return id
@@ -2,6 +2,7 @@ package speedrun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -33,6 +34,9 @@ func (c *Client) ListLevelVariables(ctx context.Context, levelID string) ([]*Var
 func (c *Client) GetVariable(ctx context.Context, variableID string) (*Variable, error) {
 	var v Variable
 	if err := c.loadItem(ctx, c.variableKey(variableID), &v); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &v, nil
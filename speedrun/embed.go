@@ -0,0 +1,340 @@
+package speedrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+// Embed names a related resource that speedrun.com can include in a response
+// instead of only its ID.
+//
+// Runs and personal bests support the singular embeds. Leaderboards and
+// records support game, category, level and players, along with the plural
+// platforms, regions and variables.
+type Embed string
+
+const (
+	EmbedGame      Embed = "game"
+	EmbedCategory  Embed = "category"
+	EmbedLevel     Embed = "level"
+	EmbedPlayers   Embed = "players"
+	EmbedPlatform  Embed = "platform"
+	EmbedRegion    Embed = "region"
+	EmbedPlatforms Embed = "platforms"
+	EmbedRegions   Embed = "regions"
+	EmbedVariables Embed = "variables"
+)
+
+func WithEmbed(embeds ...Embed) FetchOption {
+	return func(r *request) {
+		r.embeds = append(r.embeds, embeds...)
+	}
+}
+
+// WithoutEmbeds removes any embeds requested by earlier options.
+func WithoutEmbeds() FetchOption {
+	return func(r *request) {
+		r.embeds = nil
+	}
+}
+
+func (r *request) embedValue() string {
+	var embeds []string
+	for _, e := range r.embeds {
+		embeds = append(embeds, string(e))
+	}
+	return strings.Join(embeds, ",")
+}
+
+// embedded is a resource that was included in a response. Embedded resources
+// are used to prime the loader, so that resolving them later doesn't need
+// another request.
+type embedded struct {
+	collection string
+	id         string
+	data       json.RawMessage
+}
+
+// ref is a field of a response that refers to other resources. It's usually
+// an ID or a list of references, but when the resources are embedded it's an
+// object holding them as its data.
+type ref struct {
+	ID       string
+	data     json.RawMessage
+	embedded bool
+}
+
+func (r *ref) UnmarshalJSON(b []byte) error {
+	switch b[0] {
+	case '"':
+		return json.Unmarshal(b, &r.ID)
+	case '[':
+		r.data = b
+		return nil
+	case '{':
+	default:
+		return nil
+	}
+
+	var env struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(b, &env); err != nil {
+		return err
+	}
+	r.data = env.Data
+	r.embedded = true
+
+	// An embedded level is an empty list when the leaderboard isn't for a level.
+	if len(r.data) > 0 && r.data[0] == '{' {
+		var item struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(r.data, &item); err != nil {
+			return err
+		}
+		r.ID = item.ID
+	}
+
+	return nil
+}
+
+// items lists the resources embedded in the ref, which may be a single
+// resource or a list of them.
+func (r *ref) items(collection string) ([]embedded, error) {
+	if !r.embedded || len(r.data) == 0 {
+		return nil, nil
+	}
+
+	var items []json.RawMessage
+	if r.data[0] == '[' {
+		if err := json.Unmarshal(r.data, &items); err != nil {
+			return nil, err
+		}
+	} else {
+		items = []json.RawMessage{r.data}
+	}
+
+	var es []embedded
+	for _, item := range items {
+		var v struct {
+			ID  string       `json:"id"`
+			Rel RunPlayerRel `json:"rel"`
+		}
+		if err := json.Unmarshal(item, &v); err != nil {
+			return nil, err
+		}
+
+		// Guests have no ID, and aren't users.
+		if v.ID == "" || v.Rel == PlayerGuest {
+			continue
+		}
+		es = append(es, embedded{collection, v.ID, item})
+	}
+	return es, nil
+}
+
+// players decodes a list of players, which are either references to users and
+// guests or, when embedded, the users and guests themselves.
+func (r *ref) players() ([]RunPlayer, error) {
+	if len(r.data) == 0 {
+		return nil, nil
+	}
+
+	var players []struct {
+		Rel   RunPlayerRel `json:"rel"`
+		ID    string       `json:"id"`
+		Name  string       `json:"name"`
+		URI   string       `json:"uri"`
+		Links []Link       `json:"links"`
+	}
+	if err := json.Unmarshal(r.data, &players); err != nil {
+		return nil, err
+	}
+
+	var rps []RunPlayer
+	for _, p := range players {
+		rp := RunPlayer{Rel: p.Rel, ID: p.ID, Name: p.Name, URI: p.URI}
+		if r.embedded {
+			rp.URI = FindLink(p.Links, "self")
+		}
+		rps = append(rps, rp)
+	}
+	return rps, nil
+}
+
+// embeddedItems collects the resources embedded in refs, which are keyed by
+// the collection the resources belong to.
+func embeddedItems(refs map[string]*ref) ([]embedded, error) {
+	var es []embedded
+	for collection, r := range refs {
+		items, err := r.items(collection)
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, items...)
+	}
+	return es, nil
+}
+
+func (r *Run) UnmarshalJSON(b []byte) error {
+	type run Run
+	v := struct {
+		*run
+		Game     ref `json:"game"`
+		Category ref `json:"category"`
+		Level    ref `json:"level"`
+		Players  ref `json:"players"`
+		Platform ref `json:"platform"`
+		Region   ref `json:"region"`
+	}{run: (*run)(r)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	r.GameID = v.Game.ID
+	r.CategoryID = v.Category.ID
+	r.LevelID = v.Level.ID
+
+	var err error
+	if r.Players, err = v.Players.players(); err != nil {
+		return err
+	}
+
+	r.embeds, err = embeddedItems(map[string]*ref{
+		"games":      &v.Game,
+		"categories": &v.Category,
+		"levels":     &v.Level,
+		"users":      &v.Players,
+		"platforms":  &v.Platform,
+		"regions":    &v.Region,
+	})
+	return err
+}
+
+func (lb *Leaderboard) UnmarshalJSON(b []byte) error {
+	type leaderboard Leaderboard
+	v := struct {
+		*leaderboard
+		Game      ref `json:"game"`
+		Category  ref `json:"category"`
+		Level     ref `json:"level"`
+		Players   ref `json:"players"`
+		Platforms ref `json:"platforms"`
+		Regions   ref `json:"regions"`
+		Variables ref `json:"variables"`
+	}{leaderboard: (*leaderboard)(lb)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	lb.GameID = v.Game.ID
+	lb.CategoryID = v.Category.ID
+	lb.LevelID = v.Level.ID
+	lb.playersEmbedded = v.Players.embedded
+
+	var err error
+	lb.embeds, err = embeddedItems(map[string]*ref{
+		"games":      &v.Game,
+		"categories": &v.Category,
+		"levels":     &v.Level,
+		"users":      &v.Players,
+		"platforms":  &v.Platforms,
+		"regions":    &v.Regions,
+		"variables":  &v.Variables,
+	})
+	return err
+}
+
+// Players returns the users embedded in the leaderboard, keyed by ID. It
+// returns nil if the leaderboard was fetched without EmbedPlayers.
+func (lb *Leaderboard) Players() (map[string]*User, error) {
+	if !lb.playersEmbedded {
+		return nil, nil
+	}
+
+	users := make(map[string]*User)
+	for _, e := range lb.embeds {
+		if e.collection != "users" {
+			continue
+		}
+
+		var u User
+		if err := json.Unmarshal(e.data, &u); err != nil {
+			return nil, err
+		}
+		users[e.id] = &u
+	}
+	return users, nil
+}
+
+// UnmarshalJSON decodes a placed run. Personal bests embed resources next to
+// the run rather than inside it.
+func (pr *PlacedRun) UnmarshalJSON(b []byte) error {
+	type placedRun PlacedRun
+	v := struct {
+		*placedRun
+		Game     ref `json:"game"`
+		Category ref `json:"category"`
+		Level    ref `json:"level"`
+		Players  ref `json:"players"`
+		Platform ref `json:"platform"`
+		Region   ref `json:"region"`
+	}{placedRun: (*placedRun)(pr)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	var err error
+	pr.embeds, err = embeddedItems(map[string]*ref{
+		"games":      &v.Game,
+		"categories": &v.Category,
+		"levels":     &v.Level,
+		"users":      &v.Players,
+		"platforms":  &v.Platform,
+		"regions":    &v.Region,
+	})
+	return err
+}
+
+// primeEmbeds adds embedded resources to the loader attached to ctx, if there
+// is one.
+func (c *Client) primeEmbeds(ctx context.Context, es []embedded) {
+	l, ok := ctx.Value(loaderKey{c}).(*dataloader.Loader)
+	if !ok {
+		return
+	}
+
+	for _, e := range es {
+		key := fmt.Sprintf("%s/%s/%s", c.BaseURL, e.collection, e.id)
+		l.Prime(ctx, dataloader.StringKey(key), &EnvelopeResponse{Data: e.data})
+	}
+}
+
+func (c *Client) primeRuns(ctx context.Context, runs []*Run) {
+	for _, run := range runs {
+		if run != nil {
+			c.primeEmbeds(ctx, run.embeds)
+		}
+	}
+}
+
+func (c *Client) primePlacedRuns(ctx context.Context, prs []PlacedRun) {
+	for _, pr := range prs {
+		c.primeEmbeds(ctx, pr.embeds)
+		c.primeRuns(ctx, []*Run{pr.Run})
+	}
+}
+
+func (c *Client) primeLeaderboards(ctx context.Context, lbs []*Leaderboard) {
+	for _, lb := range lbs {
+		if lb != nil {
+			c.primeEmbeds(ctx, lb.embeds)
+			c.primePlacedRuns(ctx, lb.Runs)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package speedrun
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound is matched by errors for resources that speedrun.com doesn't have.
+var ErrNotFound = errors.New("speedrun: resource not found")
+
+// APIError is returned when speedrun.com responds with an unsuccessful status.
+type APIError struct {
+	StatusCode int
+	Message    string
+	URL        string
+	Links      []Link
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("unexpected status code for url %s: %d", e.URL, e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status code for url %s: %d: %s", e.URL, e.StatusCode, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// RateLimited reports whether the error is due to speedrun.com throttling us.
+func (e *APIError) RateLimited() bool {
+	return e.StatusCode == 420 || e.StatusCode == http.StatusTooManyRequests
+}
+
+// Unauthorized reports whether the error is due to a missing or invalid API key.
+func (e *APIError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+func newAPIError(u string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		URL:        u,
+	}
+
+	var resp struct {
+		Message string `json:"message"`
+		Links   []Link `json:"links"`
+	}
+	if err := json.Unmarshal(body, &resp); err == nil {
+		apiErr.Message = resp.Message
+		apiErr.Links = resp.Links
+	}
+
+	return apiErr
+}
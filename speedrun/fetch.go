@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/mjm/graphql-go"
 	"github.com/mjm/graphql-go/relay"
@@ -26,6 +28,7 @@ type request struct {
 	filters []requestFilter
 	order   *requestOrder
 	paging  requestPaging
+	embeds  []Embed
 }
 
 type requestFilter struct {
@@ -80,34 +83,12 @@ func WithOffset(offset int) FetchOption {
 }
 
 func (c *Client) fetch(ctx context.Context, path string, result interface{}, opts ...FetchOption) error {
-	var r request
-	for _, opt := range opts {
-		opt(&r)
-	}
+	r := newRequest(opts)
 
 	u := c.BaseURL + path
-	values := url.Values{}
-
-	for _, filter := range r.filters {
-		value := filter.value
-		if idVal, ok := value.(graphql.ID); ok {
-			if err := relay.UnmarshalSpec(idVal, &value); err != nil {
-				return err
-			}
-		} else if reflect.TypeOf(value).ConvertibleTo(reflect.TypeOf("")) {
-			// Our enums implement Stringer to give the GraphQL version, but we want the raw string for filter values
-			value = reflect.ValueOf(value).Convert(reflect.TypeOf("")).Interface()
-		}
-		values.Set(filter.field, fmt.Sprintf("%s", value))
-	}
-
-	if r.order != nil {
-		if r.order.field != nil {
-			values.Set("orderby", strings.ToLower(*r.order.field))
-		}
-		if r.order.direction != nil {
-			values.Set("direction", strings.ToLower(string(*r.order.direction)))
-		}
+	values, err := r.queryValues()
+	if err != nil {
+		return err
 	}
 
 	if r.paging.max != nil {
@@ -116,31 +97,149 @@ func (c *Client) fetch(ctx context.Context, path string, result interface{}, opt
 	if r.paging.offset != nil {
 		values.Set("offset", fmt.Sprintf("%d", *r.paging.offset))
 	}
+	if len(r.embeds) > 0 {
+		values.Set("embed", r.embedValue())
+	}
 
 	if len(values) > 0 {
 		u += "?" + values.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	data, err := c.get(ctx, u)
 	if err != nil {
 		return err
 	}
 
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// get reads the body of the resource at u, either from the shared cache or
+// from speedrun.com. Authenticated requests always go to speedrun.com.
+func (c *Client) get(ctx context.Context, u string) ([]byte, error) {
+	authenticated := apiKeyFromContext(ctx) != ""
+	if !authenticated {
+		if data, ok := c.Cache.get(u); ok {
+			return data, nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		data, err := c.do(ctx, u, attempt)
+		if retry, ok := err.(*retryError); ok {
+			if err := sleep(ctx, retry.after); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !authenticated {
+			c.Cache.add(u, c.resourceKind(u), data)
+		}
+		return data, nil
+	}
+}
+
+type retryError struct {
+	after time.Duration
+}
+
+func (e *retryError) Error() string {
+	return fmt.Sprintf("retry after %v", e.after)
+}
+
+// do makes a single attempt at fetching u. If the request should be retried,
+// it returns a *retryError saying how long to wait before trying again.
+func (c *Client) do(ctx context.Context, u string, attempt int) ([]byte, error) {
+	limiter := c.rateLimiter()
+	if err := limiter.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer limiter.release()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if key := apiKeyFromContext(ctx); key != "" {
+		req.Header.Set("X-API-Key", key)
+	}
+
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer res.Body.Close()
 
+	if shouldRetry(res.StatusCode) && attempt < c.MaxRetries {
+		if delay, ok := c.retryDelay(res, attempt); ok {
+			return nil, &retryError{delay}
+		}
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
 	if res.StatusCode > 299 {
-		return fmt.Errorf("unexpected status code for url %s: %d", u, res.StatusCode)
+		return nil, newAPIError(u, res.StatusCode, data)
 	}
 
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return err
+	return data, nil
+}
+
+// QueryKey describes the filters and ordering selected by opts, ignoring any
+// paging. Lists fetched with options that have the same key contain the
+// same items in the same order.
+func QueryKey(opts ...FetchOption) (string, error) {
+	values, err := newRequest(opts).queryValues()
+	if err != nil {
+		return "", err
 	}
+	return values.Encode(), nil
+}
 
-	return nil
+func newRequest(opts []FetchOption) *request {
+	var r request
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+func (r *request) queryValues() (url.Values, error) {
+	values := url.Values{}
+
+	for _, filter := range r.filters {
+		value := filter.value
+		if idVal, ok := value.(graphql.ID); ok {
+			if err := relay.UnmarshalSpec(idVal, &value); err != nil {
+				return nil, err
+			}
+		} else if reflect.TypeOf(value).Kind() == reflect.String {
+			// Our enums implement Stringer to give the GraphQL version, but we want the raw string for filter values
+			value = reflect.ValueOf(value).String()
+		}
+		values.Set(filter.field, fmt.Sprint(value))
+	}
+
+	if r.order != nil {
+		if r.order.field != nil {
+			values.Set("orderby", strings.ToLower(*r.order.field))
+		}
+		if r.order.direction != nil {
+			values.Set("direction", strings.ToLower(string(*r.order.direction)))
+		}
+	}
+
+	return values, nil
 }
 
 func filtersFromStruct(val interface{}) []requestFilter {
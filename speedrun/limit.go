@@ -0,0 +1,147 @@
+package speedrun
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket that also caps the number of requests in flight.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	inFlight chan struct{}
+}
+
+func newLimiter(perMinute int, maxConcurrent int) *limiter {
+	l := &limiter{}
+	if perMinute > 0 {
+		l.rate = float64(perMinute) / 60
+		// The bucket only holds a few seconds' worth of requests, and starts
+		// with a single token, so no minute sees much more than perMinute
+		// requests, even right after the process starts.
+		l.burst = math.Max(1, float64(perMinute)/10)
+		l.tokens = 1
+		l.last = time.Now()
+	}
+	if maxConcurrent > 0 {
+		l.inFlight = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent. Callers must call release once
+// the response has been read.
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := l.take(ctx); err != nil {
+		l.release()
+		return err
+	}
+	return nil
+}
+
+func (l *limiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+func (l *limiter) take(ctx context.Context) error {
+	if l.rate == 0 {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) rateLimiter() *limiter {
+	c.limiterOnce.Do(func() {
+		c.limiter = newLimiter(c.RequestsPerMinute, c.MaxConcurrentRequests)
+	})
+	return c.limiter
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == 420 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay determines how long to wait before retrying a request, honoring
+// the Retry-After header if speedrun.com sent one. It returns false if
+// speedrun.com asked for a wait longer than MaxRetryDelay, in which case the
+// request shouldn't be retried.
+func (c *Client) retryDelay(res *http.Response, attempt int) (time.Duration, bool) {
+	if after := res.Header.Get("Retry-After"); after != "" {
+		var delay time.Duration
+		var ok bool
+		if secs, err := strconv.Atoi(after); err == nil {
+			delay, ok = time.Duration(secs)*time.Second, true
+		} else if t, err := http.ParseTime(after); err == nil {
+			delay, ok = time.Until(t), true
+		}
+
+		if ok {
+			if c.MaxRetryDelay > 0 && delay > c.MaxRetryDelay {
+				return 0, false
+			}
+			return delay, true
+		}
+	}
+
+	delay := c.RetryBackoff << uint(attempt)
+	if c.MaxRetryDelay > 0 && delay > c.MaxRetryDelay {
+		delay = c.MaxRetryDelay
+	}
+	return delay, true
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,185 @@
+package speedrun
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterBurst(t *testing.T) {
+	// 600 per minute allows a burst of 60 after a quiet period, then one
+	// request every 100ms.
+	l := newLimiter(600, 0)
+	l.tokens = l.burst
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+		l.release()
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("burst took %v, want no waiting", elapsed)
+	}
+
+	start = time.Now()
+	if err := l.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	l.release()
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("request after the burst took %v, want it to wait for a token", elapsed)
+	}
+}
+
+func TestLimiterFirstWindow(t *testing.T) {
+	// At 6000 per minute, a new limiter allows 100 requests a second, plus the
+	// one it starts with. It doesn't allow a burst on top of that.
+	l := newLimiter(6000, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	n := 0
+	for l.acquire(ctx) == nil {
+		l.release()
+		n++
+	}
+	if n < 1 || n > 21 {
+		t.Errorf("sent %d requests in the first 200ms, want at most 21", n)
+	}
+}
+
+func TestLimiterCanceled(t *testing.T) {
+	l := newLimiter(1, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	l.release()
+
+	if err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("acquire() = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	l := newLimiter(0, 2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() with 2 in flight = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	l.release()
+	if err := l.acquire(ctx); err != nil {
+		t.Errorf("acquire() after release = %v", err)
+	}
+}
+
+// throttlingServer responds with status for the first n requests, and
+// successfully after that.
+func throttlingServer(t *testing.T, status int, n int32, retryAfter string) (*Client, *int32) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= n {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"status":420,"message":"slow down"}`))
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(srv.URL)
+	c.Cache = nil
+	c.RequestsPerMinute = 0
+	c.RetryBackoff = time.Millisecond
+	c.MaxRetryDelay = 100 * time.Millisecond
+	return c, &requests
+}
+
+func TestRetryThrottled(t *testing.T) {
+	for _, status := range []int{420, http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		c, requests := throttlingServer(t, status, 2, "")
+
+		if _, err := c.get(context.Background(), c.BaseURL+"/games"); err != nil {
+			t.Errorf("status %d: get() = %v, want success after retrying", status, err)
+		}
+		if *requests != 3 {
+			t.Errorf("status %d: made %d requests, want 3", status, *requests)
+		}
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	c, requests := throttlingServer(t, 420, 10, "")
+
+	_, err := c.get(context.Background(), c.BaseURL+"/games")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.RateLimited() {
+		t.Fatalf("get() = %v, want a rate limited APIError", err)
+	}
+	if want := int32(c.MaxRetries + 1); *requests != want {
+		t.Errorf("made %d requests, want %d", *requests, want)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	c, requests := throttlingServer(t, http.StatusTooManyRequests, 1, "0")
+
+	if _, err := c.get(context.Background(), c.BaseURL+"/games"); err != nil {
+		t.Fatalf("get() = %v", err)
+	}
+	if *requests != 2 {
+		t.Errorf("made %d requests, want 2", *requests)
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	c, requests := throttlingServer(t, http.StatusTooManyRequests, 1, "3600")
+
+	start := time.Now()
+	_, err := c.get(context.Background(), c.BaseURL+"/games")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.RateLimited() {
+		t.Fatalf("get() = %v, want a rate limited APIError", err)
+	}
+	if *requests != 1 {
+		t.Errorf("made %d requests, want 1", *requests)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("get() took %v, want it to fail without waiting", elapsed)
+	}
+}
+
+func TestRetryBackoffClamped(t *testing.T) {
+	c := NewClient("https://example.com")
+	c.RetryBackoff = time.Second
+	c.MaxRetryDelay = 5 * time.Second
+
+	res := &http.Response{Header: http.Header{}}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got, ok := c.retryDelay(res, attempt); !ok || got != want {
+			t.Errorf("retryDelay(attempt %d) = %v, %v, want %v", attempt, got, ok, want)
+		}
+	}
+}
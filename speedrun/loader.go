@@ -3,8 +3,7 @@ package speedrun
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
+	"errors"
 	"sync"
 
 	"github.com/graph-gophers/dataloader"
@@ -20,28 +19,14 @@ func (c *Client) newLoader() *dataloader.Loader {
 			go func(i int, key dataloader.Key) {
 				defer wg.Done()
 
-				req, err := http.NewRequestWithContext(ctx, http.MethodGet, key.String(), nil)
+				data, err := c.get(ctx, key.String())
 				if err != nil {
 					results[i] = &dataloader.Result{Error: err}
 					return
 				}
 
-				res, err := c.HTTPClient.Do(req)
-				if err != nil {
-					results[i] = &dataloader.Result{Error: err}
-					return
-				}
-				defer res.Body.Close()
-
-				if res.StatusCode > 299 {
-					results[i] = &dataloader.Result{
-						Error: fmt.Errorf("Unexpected status code for key %q: %d", key, res.StatusCode),
-					}
-					return
-				}
-
 				var resp EnvelopeResponse
-				if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+				if err := json.Unmarshal(data, &resp); err != nil {
 					results[i] = &dataloader.Result{Error: err}
 					return
 				}
@@ -55,8 +40,29 @@ func (c *Client) newLoader() *dataloader.Loader {
 	})
 }
 
+type loaderKey struct {
+	client *Client
+}
+
+// WithLoader returns a copy of ctx carrying a new loader for the client.
+//
+// Items loaded with the returned context are batched and cached only for as
+// long as the context is used, which should usually be a single GraphQL request.
+func (c *Client) WithLoader(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loaderKey{c}, c.newLoader())
+}
+
+func (c *Client) loaderFromContext(ctx context.Context) *dataloader.Loader {
+	if l, ok := ctx.Value(loaderKey{c}).(*dataloader.Loader); ok {
+		return l
+	}
+
+	// Without a request-scoped loader, nothing should be cached beyond this call.
+	return c.newLoader()
+}
+
 func (c *Client) loadItem(ctx context.Context, path string, result interface{}) error {
-	res, err := c.loader.Load(ctx, dataloader.StringKey(path))()
+	res, err := c.loaderFromContext(ctx).Load(ctx, dataloader.StringKey(path))()
 	if err != nil {
 		return err
 	}
@@ -64,3 +70,27 @@ func (c *Client) loadItem(ctx context.Context, path string, result interface{})
 	data := res.(*EnvelopeResponse).Data
 	return json.Unmarshal(data, result)
 }
+
+// loadItems loads the data for each of the paths, leaving out any that
+// speedrun.com doesn't have.
+func (c *Client) loadItems(ctx context.Context, paths []string) ([]json.RawMessage, error) {
+	var keys dataloader.Keys
+	for _, path := range paths {
+		keys = append(keys, dataloader.StringKey(path))
+	}
+
+	ress, errs := c.loaderFromContext(ctx).LoadMany(ctx, keys)()
+
+	var items []json.RawMessage
+	for i, res := range ress {
+		if errs != nil && errs[i] != nil {
+			if errors.Is(errs[i], ErrNotFound) {
+				continue
+			}
+			return nil, errs[i]
+		}
+
+		items = append(items, res.(*EnvelopeResponse).Data)
+	}
+	return items, nil
+}
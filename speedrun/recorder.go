@@ -0,0 +1,147 @@
+package speedrun
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// RecordingTransport is an http.RoundTripper that saves every response it
+// receives into Dir, so that it can be replayed later by a ReplayTransport.
+//
+// Responses to requests made with an API key are not saved, since they may
+// include things only that user can see, and fixtures aren't keyed by user.
+type RecordingTransport struct {
+	Dir string
+
+	// Transport makes the real requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Header.Get("X-API-Key") != "" {
+		return res, nil
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &fixture{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       string(body),
+	}
+	if err := f.write(t.Dir); err != nil {
+		return nil, err
+	}
+
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+// ReplayTransport is an http.RoundTripper that answers requests with the
+// responses saved in Dir by a RecordingTransport, without using the network.
+type ReplayTransport struct {
+	Dir string
+}
+
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f, err := readFixture(t.Dir, req.Method, req.URL.String())
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(f.Body))),
+		ContentLength: int64(len(f.Body)),
+		Request:       req,
+	}, nil
+}
+
+type fixture struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+func fixturePath(dir string, method string, u string) string {
+	sum := sha256.Sum256([]byte(method + " " + u))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func readFixture(dir string, method string, u string) (*fixture, error) {
+	data, err := ioutil.ReadFile(fixturePath(dir, method, u))
+	if err != nil {
+		return nil, err
+	}
+
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (f *fixture) write(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and move it into place, so that recordings of
+	// the same request running at once can't leave a corrupt fixture behind.
+	tmp, err := ioutil.TempFile(dir, ".fixture-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), fixturePath(dir, f.Method, f.URL))
+}
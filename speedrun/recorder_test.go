@@ -0,0 +1,110 @@
+package speedrun
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get("X-API-Key"); key != "" {
+			w.Write([]byte(`{"data":{"id":"` + key + `"}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"id":"sm64"}}`))
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := NewClient(srv.URL)
+	c.Cache = nil
+	c.HTTPClient.Transport = &RecordingTransport{Dir: dir}
+
+	ctx := context.Background()
+	game, err := c.GetGame(ctx, "sm64")
+	if err != nil || game == nil || game.ID != "sm64" {
+		t.Fatalf("GetGame() = %v, %v", game, err)
+	}
+	profile, err := c.GetProfile(WithAPIKey(ctx, "secret"))
+	if err != nil || profile == nil || profile.ID != "secret" {
+		t.Fatalf("GetProfile() = %v, %v", profile, err)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("recorded %d responses, want only the unauthenticated one", len(files))
+	}
+
+	srv.Close()
+	c.HTTPClient.Transport = &ReplayTransport{Dir: dir}
+
+	game, err = c.GetGame(ctx, "sm64")
+	if err != nil || game == nil || game.ID != "sm64" {
+		t.Errorf("replayed GetGame() = %v, %v", game, err)
+	}
+	if _, err := c.GetProfile(WithAPIKey(ctx, "other")); err == nil {
+		t.Errorf("replayed GetProfile() succeeded, want no recorded response")
+	}
+}
+
+func TestFixtureWriteConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			f := &fixture{
+				Method:     http.MethodGet,
+				URL:        "https://www.speedrun.com/api/v1/games/sm64",
+				StatusCode: http.StatusOK,
+				Body:       strings.Repeat(strconv.Itoa(i), 100000),
+			}
+			errs <- f.write(dir)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := readFixture(dir, http.MethodGet, "https://www.speedrun.com/api/v1/games/sm64")
+	if err != nil {
+		t.Fatalf("reading the fixture: %v", err)
+	}
+	if len(f.Body) != 100000 && len(f.Body) != 200000 {
+		t.Errorf("fixture body has length %d, want one whole write", len(f.Body))
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("directory has %d files, want just the fixture", len(files))
+	}
+}
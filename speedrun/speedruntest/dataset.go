@@ -0,0 +1,108 @@
+package speedruntest
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+// Dataset is the data served by a Server.
+//
+// Categories, levels and variables belong to the game named by their "game"
+// link, which only needs to end in the game's ID.
+type Dataset struct {
+	Games      []*speedrun.Game
+	Categories []*speedrun.Category
+	Levels     []*speedrun.Level
+	Variables  []*speedrun.Variable
+	Platforms  []*speedrun.Platform
+	Regions    []*speedrun.Region
+	Genres     []*speedrun.Genre
+	Engines    []*speedrun.Engine
+	GameTypes  []*speedrun.GameType
+	Developers []*speedrun.Developer
+	Publishers []*speedrun.Publisher
+	Series     []*speedrun.Series
+	Users      []*speedrun.User
+	Runs       []*speedrun.Run
+
+	// APIKeys maps the API keys the server accepts to the IDs of the users
+	// they belong to.
+	APIKeys map[string]string
+
+	// Notifications are the notifications of each user, keyed by user ID.
+	Notifications map[string][]*speedrun.Notification
+}
+
+func (d *Dataset) game(id string) *speedrun.Game {
+	for _, g := range d.Games {
+		if g.ID == id || strings.EqualFold(g.Abbreviation, id) {
+			return g
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) category(id string) *speedrun.Category {
+	for _, c := range d.Categories {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) level(id string) *speedrun.Level {
+	for _, l := range d.Levels {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) variable(id string) *speedrun.Variable {
+	for _, v := range d.Variables {
+		if v.ID == id {
+			return v
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) series(id string) *speedrun.Series {
+	for _, s := range d.Series {
+		if s.ID == id || strings.EqualFold(s.Abbreviation, id) {
+			return s
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) user(id string) *speedrun.User {
+	for _, u := range d.Users {
+		if u.ID == id || strings.EqualFold(u.Names.International, id) {
+			return u
+		}
+	}
+	return nil
+}
+
+func (d *Dataset) run(id string) *speedrun.Run {
+	for _, r := range d.Runs {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// linkedID finds the ID of the resource linked from links with the given rel.
+func linkedID(links []speedrun.Link, rel string) string {
+	uri := speedrun.FindLink(links, rel)
+	if uri == "" {
+		return ""
+	}
+	return path.Base(uri)
+}
@@ -0,0 +1,219 @@
+package speedruntest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+// embeds lists the resources named by the embed query parameter.
+func embeds(r *http.Request) map[string]bool {
+	es := make(map[string]bool)
+	for _, e := range strings.Split(r.URL.Query().Get("embed"), ",") {
+		if e != "" {
+			es[e] = true
+		}
+	}
+	return es
+}
+
+// object encodes v as a JSON object, so that fields can be added to it or
+// replaced with embedded resources.
+func object(v interface{}) map[string]interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// embedded wraps a resource the way speedrun.com does when it's embedded. A
+// missing resource is embedded as an empty list.
+func embedded(v interface{}) map[string]interface{} {
+	if v == nil {
+		v = []interface{}{}
+	}
+	return map[string]interface{}{"data": v}
+}
+
+// embedRun encodes a run with the resources in es embedded in place of their
+// IDs.
+func (s *Server) embedRun(run *speedrun.Run, es map[string]bool) interface{} {
+	if len(es) == 0 {
+		return run
+	}
+
+	obj := object(run)
+	s.addRunEmbeds(obj, run, es)
+	return obj
+}
+
+// addRunEmbeds adds the resources in es that are related to run to obj.
+func (s *Server) addRunEmbeds(obj map[string]interface{}, run *speedrun.Run, es map[string]bool) {
+	if es["game"] {
+		obj["game"] = embedded(s.gameResource(run.GameID))
+	}
+	if es["category"] {
+		obj["category"] = embedded(s.categoryResource(run.CategoryID))
+	}
+	if es["level"] {
+		obj["level"] = embedded(s.levelResource(run.LevelID))
+	}
+	if es["players"] {
+		obj["players"] = embedded(s.players([]*speedrun.Run{run}))
+	}
+	if es["platform"] {
+		obj["platform"] = embedded(s.platformResource(run.System.PlatformID))
+	}
+	if es["region"] {
+		obj["region"] = embedded(s.regionResource(run.System.RegionID))
+	}
+}
+
+// embedLeaderboard encodes a leaderboard with the resources in es embedded in
+// place of their IDs.
+func (s *Server) embedLeaderboard(lb *speedrun.Leaderboard, es map[string]bool) interface{} {
+	if len(es) == 0 {
+		return lb
+	}
+
+	var runs []*speedrun.Run
+	for _, pr := range lb.Runs {
+		runs = append(runs, pr.Run)
+	}
+
+	obj := object(lb)
+	if es["game"] {
+		obj["game"] = embedded(s.gameResource(lb.GameID))
+	}
+	if es["category"] {
+		obj["category"] = embedded(s.categoryResource(lb.CategoryID))
+	}
+	if es["level"] {
+		obj["level"] = embedded(s.levelResource(lb.LevelID))
+	}
+	if es["players"] {
+		obj["players"] = embedded(s.players(runs))
+	}
+	if es["platforms"] {
+		var platforms []interface{}
+		for _, p := range s.data.Platforms {
+			platforms = append(platforms, p)
+		}
+		obj["platforms"] = embedded(platforms)
+	}
+	if es["regions"] {
+		var regions []interface{}
+		for _, r := range s.data.Regions {
+			regions = append(regions, r)
+		}
+		obj["regions"] = embedded(regions)
+	}
+	if es["variables"] {
+		var vars []interface{}
+		for _, v := range s.data.Variables {
+			if linkedID(v.Links, "game") == lb.GameID {
+				vars = append(vars, v)
+			}
+		}
+		obj["variables"] = embedded(vars)
+	}
+	return obj
+}
+
+// embedPlacedRun encodes a personal best with the resources in es embedded
+// next to its run.
+func (s *Server) embedPlacedRun(pr speedrun.PlacedRun, es map[string]bool) interface{} {
+	if len(es) == 0 {
+		return pr
+	}
+
+	obj := object(pr)
+	s.addRunEmbeds(obj, pr.Run, es)
+	return obj
+}
+
+// players lists the distinct players of runs, as users or guests.
+func (s *Server) players(runs []*speedrun.Run) []interface{} {
+	var players []interface{}
+	seen := make(map[string]bool)
+	for _, run := range runs {
+		for _, p := range run.Players {
+			key := string(p.Rel) + ":" + p.ID + p.Name
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			switch p.Rel {
+			case speedrun.PlayerUser:
+				u := s.data.user(p.ID)
+				if u == nil {
+					continue
+				}
+				obj := object(u)
+				obj["rel"] = p.Rel
+				players = append(players, obj)
+			case speedrun.PlayerGuest:
+				players = append(players, map[string]interface{}{
+					"rel":  p.Rel,
+					"name": p.Name,
+					"links": []speedrun.Link{
+						{Rel: "self", URI: s.BaseURL() + "/guests/" + url.PathEscape(p.Name)},
+					},
+				})
+			}
+		}
+	}
+	return players
+}
+
+// The resource lookups below return nil interfaces rather than typed nil
+// pointers, so that missing resources are embedded as empty lists.
+
+func (s *Server) gameResource(id string) interface{} {
+	if g := s.data.game(id); g != nil {
+		return g
+	}
+	return nil
+}
+
+func (s *Server) categoryResource(id string) interface{} {
+	if c := s.data.category(id); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (s *Server) levelResource(id string) interface{} {
+	if l := s.data.level(id); l != nil {
+		return l
+	}
+	return nil
+}
+
+func (s *Server) platformResource(id string) interface{} {
+	for _, p := range s.data.Platforms {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) regionResource(id string) interface{} {
+	for _, r := range s.data.Regions {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package speedruntest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+var gameOrders = map[string]lessFunc{
+	"name.int": func(a, b interface{}) bool {
+		return strings.ToLower(a.(*speedrun.Game).Names.International) < strings.ToLower(b.(*speedrun.Game).Names.International)
+	},
+	"name.jap": func(a, b interface{}) bool {
+		return a.(*speedrun.Game).Names.Japanese < b.(*speedrun.Game).Names.Japanese
+	},
+	"abbreviation": func(a, b interface{}) bool {
+		return a.(*speedrun.Game).Abbreviation < b.(*speedrun.Game).Abbreviation
+	},
+	"released": func(a, b interface{}) bool {
+		return a.(*speedrun.Game).ReleaseDate < b.(*speedrun.Game).ReleaseDate
+	},
+	// Games are kept in the order they were created.
+	"created": func(a, b interface{}) bool {
+		return false
+	},
+	"similarity": func(a, b interface{}) bool {
+		return strings.ToLower(a.(*speedrun.Game).Names.International) < strings.ToLower(b.(*speedrun.Game).Names.International)
+	},
+}
+
+func (s *Server) serveGames(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) == 0 {
+		s.listGames(w, r, s.data.Games)
+		return true
+	}
+
+	g := s.data.game(segments[0])
+	if g == nil {
+		return false
+	}
+
+	if len(segments) == 1 {
+		writeData(w, g)
+		return true
+	}
+	if len(segments) > 2 {
+		return false
+	}
+
+	q := r.URL.Query()
+
+	switch segments[1] {
+	case "categories":
+		misc, err := boolParam(q, "miscellaneous")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return true
+		}
+
+		cats := []*speedrun.Category{}
+		for _, c := range s.data.Categories {
+			if linkedID(c.Links, "game") != g.ID {
+				continue
+			}
+			if misc != nil && c.Miscellaneous != *misc {
+				continue
+			}
+			cats = append(cats, c)
+		}
+		writeData(w, cats)
+	case "levels":
+		levels := []*speedrun.Level{}
+		for _, l := range s.data.Levels {
+			if linkedID(l.Links, "game") == g.ID {
+				levels = append(levels, l)
+			}
+		}
+		writeData(w, levels)
+	case "variables":
+		vars := []*speedrun.Variable{}
+		for _, v := range s.data.Variables {
+			if linkedID(v.Links, "game") == g.ID {
+				vars = append(vars, v)
+			}
+		}
+		writeData(w, vars)
+	case "derived-games":
+		var derived []*speedrun.Game
+		for _, dg := range s.data.Games {
+			if linkedID(dg.Links, "base-game") == g.ID {
+				derived = append(derived, dg)
+			}
+		}
+		s.listGames(w, r, derived)
+	case "records":
+		var cats []*speedrun.Category
+		for _, c := range s.data.Categories {
+			if linkedID(c.Links, "game") == g.ID {
+				cats = append(cats, c)
+			}
+		}
+
+		var levels []*speedrun.Level
+		for _, l := range s.data.Levels {
+			if linkedID(l.Links, "game") == g.ID {
+				levels = append(levels, l)
+			}
+		}
+
+		s.writeRecords(w, r, g, cats, levels)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) listGames(w http.ResponseWriter, r *http.Request, games []*speedrun.Game) {
+	q := r.URL.Query()
+
+	if released := q.Get("released"); released != "" {
+		if _, err := strconv.Atoi(released); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid released value.")
+			return
+		}
+	}
+
+	var items []interface{}
+	for _, g := range games {
+		if name := q.Get("name"); name != "" && !containsFold(g.Names.International, name) {
+			continue
+		}
+		if abbr := q.Get("abbreviation"); abbr != "" && !strings.EqualFold(g.Abbreviation, abbr) {
+			continue
+		}
+		if released := q.Get("released"); released != "" && !strings.HasPrefix(g.ReleaseDate, released) {
+			continue
+		}
+		if p := q.Get("platform"); p != "" && !containsString(g.Platforms, p) {
+			continue
+		}
+		if reg := q.Get("region"); reg != "" && !containsString(g.Regions, reg) {
+			continue
+		}
+		if genre := q.Get("genre"); genre != "" && !containsString(g.Genres, genre) {
+			continue
+		}
+		if engine := q.Get("engine"); engine != "" && !containsString(g.Engines, engine) {
+			continue
+		}
+		if gt := q.Get("gametype"); gt != "" && !containsString(g.GameTypes, gt) {
+			continue
+		}
+		if dev := q.Get("developer"); dev != "" && !containsString(g.Developers, dev) {
+			continue
+		}
+		if pub := q.Get("publisher"); pub != "" && !containsString(g.Publishers, pub) {
+			continue
+		}
+		if mod := q.Get("moderator"); mod != "" {
+			if _, ok := g.Moderators[mod]; !ok {
+				continue
+			}
+		}
+
+		items = append(items, g)
+	}
+
+	if sortItems(w, r, items, gameOrders, "name.int") {
+		writePage(w, r, items)
+	}
+}
@@ -0,0 +1,36 @@
+package speedruntest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+// Guests aren't stored in the dataset: a guest exists as long as some run was
+// performed by them.
+func (s *Server) serveGuests(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 1 {
+		return false
+	}
+
+	for _, run := range s.data.Runs {
+		for _, p := range run.Players {
+			if p.Rel != speedrun.PlayerGuest || !strings.EqualFold(p.Name, segments[0]) {
+				continue
+			}
+
+			name := url.PathEscape(p.Name)
+			writeData(w, speedrun.Guest{
+				Name: p.Name,
+				Links: []speedrun.Link{
+					{Rel: "self", URI: s.BaseURL() + "/guests/" + name},
+					{Rel: "runs", URI: s.BaseURL() + "/runs?guest=" + url.QueryEscape(p.Name)},
+				},
+			})
+			return true
+		}
+	}
+	return false
+}
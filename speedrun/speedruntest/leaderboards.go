@@ -0,0 +1,315 @@
+package speedruntest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (s *Server) serveLeaderboards(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	var gameID, levelID, categoryID string
+	switch {
+	case len(segments) == 3 && segments[1] == "category":
+		gameID, categoryID = segments[0], segments[2]
+	case len(segments) == 5 && segments[1] == "level" && segments[3] == "category":
+		gameID, levelID, categoryID = segments[0], segments[2], segments[4]
+	default:
+		return false
+	}
+
+	g := s.data.game(gameID)
+	c := s.data.category(categoryID)
+	if g == nil || c == nil {
+		return false
+	}
+	if levelID != "" && s.data.level(levelID) == nil {
+		return false
+	}
+
+	lb, err := s.leaderboard(g, c, levelID, r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return true
+	}
+
+	writeData(w, s.embedLeaderboard(lb, embeds(r)))
+	return true
+}
+
+// leaderboard ranks the verified runs of a category, keeping only the best run
+// of each player or team. Runs with equal times share a place.
+func (s *Server) leaderboard(g *speedrun.Game, c *speedrun.Category, levelID string, q url.Values) (*speedrun.Leaderboard, error) {
+	top, err := intParam(q, "top", 0)
+	if err != nil {
+		return nil, err
+	}
+	emulators, err := boolParam(q, "emulators")
+	if err != nil {
+		return nil, err
+	}
+	videoOnly, err := boolParam(q, "video-only")
+	if err != nil {
+		return nil, err
+	}
+
+	timing := g.Ruleset.DefaultRunTime
+	switch t := speedrun.GameRunTime(q.Get("timing")); t {
+	case "":
+	case speedrun.RealTime, speedrun.RealTimeNoLoads, speedrun.InGame:
+		timing = t
+	default:
+		return nil, fmt.Errorf("invalid timing value %q", t)
+	}
+
+	values := make(map[string]string)
+	for key := range q {
+		if strings.HasPrefix(key, "var-") {
+			values[strings.TrimPrefix(key, "var-")] = q.Get(key)
+		}
+	}
+
+	var runs []*speedrun.Run
+	for _, run := range s.data.Runs {
+		if run.GameID != g.ID || run.CategoryID != c.ID || run.LevelID != levelID {
+			continue
+		}
+		if run.Status.Status != speedrun.RunVerified || !hasValues(run, values) {
+			continue
+		}
+		if runTime(run, timing) == 0 {
+			continue
+		}
+		if platform := q.Get("platform"); platform != "" && run.System.PlatformID != platform {
+			continue
+		}
+		if region := q.Get("region"); region != "" && run.System.RegionID != region {
+			continue
+		}
+		if emulators != nil && run.System.Emulated != *emulators {
+			continue
+		}
+		if videoOnly != nil && *videoOnly && (run.Videos == nil || len(run.Videos.Links) == 0) {
+			continue
+		}
+		if date := q.Get("date"); date != "" && run.Date > date {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		ti, tj := runTime(runs[i], timing), runTime(runs[j], timing)
+		if ti == tj {
+			return runs[i].Date < runs[j].Date
+		}
+		return ti < tj
+	})
+
+	lb := &speedrun.Leaderboard{
+		GameID:     g.ID,
+		CategoryID: c.ID,
+		LevelID:    levelID,
+		Timing:     timing,
+		Runs:       []speedrun.PlacedRun{},
+	}
+
+	seen := make(map[string]bool)
+	var place int
+	for _, run := range runs {
+		key := playersKey(run)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		n := len(lb.Runs)
+		if n == 0 || runTime(lb.Runs[n-1].Run, timing) != runTime(run, timing) {
+			place = n + 1
+		}
+		if top > 0 && place > top {
+			break
+		}
+
+		lb.Runs = append(lb.Runs, speedrun.PlacedRun{Place: place, Run: run})
+	}
+
+	return lb, nil
+}
+
+// runTime is the time of the run using the given timing method, or zero if
+// the run wasn't timed that way.
+func runTime(run *speedrun.Run, timing speedrun.GameRunTime) float64 {
+	switch timing {
+	case speedrun.RealTime:
+		return run.Times.RealTime
+	case speedrun.RealTimeNoLoads:
+		return run.Times.RealTimeNoLoads
+	case speedrun.InGame:
+		return run.Times.InGame
+	default:
+		return run.Times.Primary
+	}
+}
+
+// writeRecords writes the top runs of each leaderboard formed by cats and
+// levels, like the records endpoints of games, categories and levels.
+func (s *Server) writeRecords(w http.ResponseWriter, r *http.Request, g *speedrun.Game, cats []*speedrun.Category, levels []*speedrun.Level) {
+	q := r.URL.Query()
+
+	misc, err := boolParam(q, "miscellaneous")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	skipEmpty, err := boolParam(q, "skip-empty")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scope := q.Get("scope")
+	switch scope {
+	case "":
+		scope = "all"
+	case "full-game", "levels", "all":
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid scope value %q.", scope))
+		return
+	}
+
+	lbQuery := url.Values{}
+	lbQuery.Set("top", "3")
+	if top := q.Get("top"); top != "" {
+		lbQuery.Set("top", top)
+	}
+
+	es := embeds(r)
+	var items []interface{}
+	addBoard := func(c *speedrun.Category, levelID string) bool {
+		lb, err := s.leaderboard(g, c, levelID, lbQuery)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return false
+		}
+		if len(lb.Runs) > 0 || skipEmpty == nil || !*skipEmpty {
+			items = append(items, s.embedLeaderboard(lb, es))
+		}
+		return true
+	}
+
+	for _, c := range cats {
+		if misc != nil && !*misc && c.Miscellaneous {
+			continue
+		}
+
+		switch c.Type {
+		case speedrun.CategoryPerGame:
+			if scope == "levels" {
+				continue
+			}
+			if !addBoard(c, "") {
+				return
+			}
+		case speedrun.CategoryPerLevel:
+			if scope == "full-game" {
+				continue
+			}
+			for _, l := range levels {
+				if !addBoard(c, l.ID) {
+					return
+				}
+			}
+		}
+	}
+
+	writePage(w, r, items)
+}
+
+func (s *Server) writePersonalBests(w http.ResponseWriter, r *http.Request, u *speedrun.User) {
+	type board struct {
+		gameID, categoryID, levelID string
+	}
+
+	var boards []board
+	seen := make(map[board]bool)
+	for _, run := range s.data.Runs {
+		if !hasPlayer(run, speedrun.PlayerUser, u.ID) {
+			continue
+		}
+
+		b := board{run.GameID, run.CategoryID, run.LevelID}
+		if !seen[b] {
+			seen[b] = true
+			boards = append(boards, b)
+		}
+	}
+
+	q := r.URL.Query()
+	lbQuery := url.Values{}
+	if top := q.Get("top"); top != "" {
+		lbQuery.Set("top", top)
+	}
+
+	es := embeds(r)
+	pbs := []interface{}{}
+	for _, b := range boards {
+		g := s.data.game(b.gameID)
+		c := s.data.category(b.categoryID)
+		if g == nil || c == nil {
+			continue
+		}
+		if game := q.Get("game"); game != "" {
+			if pg := s.data.game(game); pg == nil || pg.ID != g.ID {
+				continue
+			}
+		}
+		if series := q.Get("series"); series != "" {
+			sr := s.data.series(series)
+			if sr == nil || linkedID(g.Links, "series") != sr.ID {
+				continue
+			}
+		}
+
+		lb, err := s.leaderboard(g, c, b.levelID, lbQuery)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		for _, pr := range lb.Runs {
+			if hasPlayer(pr.Run, speedrun.PlayerUser, u.ID) {
+				pbs = append(pbs, s.embedPlacedRun(pr, es))
+			}
+		}
+	}
+
+	writeData(w, pbs)
+}
+
+func hasValues(run *speedrun.Run, values map[string]string) bool {
+	for varID, valID := range values {
+		if run.Values[varID] != valID {
+			return false
+		}
+	}
+	return true
+}
+
+// playersKey identifies the player or team that performed a run.
+func playersKey(run *speedrun.Run) string {
+	var ids []string
+	for _, p := range run.Players {
+		if p.Rel == speedrun.PlayerGuest {
+			ids = append(ids, "guest:"+strings.ToLower(p.Name))
+		} else {
+			ids = append(ids, p.ID)
+		}
+	}
+
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
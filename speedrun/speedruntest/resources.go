@@ -0,0 +1,294 @@
+package speedruntest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func (s *Server) serveCategories(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+
+	c := s.data.category(segments[0])
+	if c == nil {
+		return false
+	}
+
+	switch {
+	case len(segments) == 1:
+		writeData(w, c)
+	case len(segments) == 2 && segments[1] == "variables":
+		vars := []*speedrun.Variable{}
+		for _, v := range s.data.Variables {
+			if v.CategoryID == c.ID || (v.CategoryID == "" && linkedID(v.Links, "game") == linkedID(c.Links, "game")) {
+				vars = append(vars, v)
+			}
+		}
+		writeData(w, vars)
+	case len(segments) == 2 && segments[1] == "records":
+		g := s.data.game(linkedID(c.Links, "game"))
+		if g == nil {
+			return false
+		}
+
+		var levels []*speedrun.Level
+		for _, l := range s.data.Levels {
+			if linkedID(l.Links, "game") == g.ID {
+				levels = append(levels, l)
+			}
+		}
+
+		s.writeRecords(w, r, g, []*speedrun.Category{c}, levels)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveLevels(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+
+	l := s.data.level(segments[0])
+	if l == nil {
+		return false
+	}
+
+	gameID := linkedID(l.Links, "game")
+
+	switch {
+	case len(segments) == 1:
+		writeData(w, l)
+	case len(segments) == 2 && segments[1] == "categories":
+		cats := []*speedrun.Category{}
+		for _, c := range s.data.Categories {
+			if c.Type == speedrun.CategoryPerLevel && linkedID(c.Links, "game") == gameID {
+				cats = append(cats, c)
+			}
+		}
+		writeData(w, cats)
+	case len(segments) == 2 && segments[1] == "variables":
+		vars := []*speedrun.Variable{}
+		for _, v := range s.data.Variables {
+			if linkedID(v.Links, "game") != gameID {
+				continue
+			}
+
+			switch v.Scope.Type {
+			case speedrun.ScopeGlobal, speedrun.ScopeAllLevels:
+				vars = append(vars, v)
+			case speedrun.ScopeSingleLevel:
+				if v.Scope.LevelID == l.ID {
+					vars = append(vars, v)
+				}
+			}
+		}
+		writeData(w, vars)
+	case len(segments) == 2 && segments[1] == "records":
+		g := s.data.game(gameID)
+		if g == nil {
+			return false
+		}
+
+		var cats []*speedrun.Category
+		for _, c := range s.data.Categories {
+			if c.Type == speedrun.CategoryPerLevel && linkedID(c.Links, "game") == gameID {
+				cats = append(cats, c)
+			}
+		}
+
+		s.writeRecords(w, r, g, cats, []*speedrun.Level{l})
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveVariables(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 1 {
+		return false
+	}
+
+	v := s.data.variable(segments[0])
+	if v == nil {
+		return false
+	}
+
+	writeData(w, v)
+	return true
+}
+
+func (s *Server) serveEngines(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 1 {
+		return false
+	}
+
+	for _, e := range s.data.Engines {
+		if e.ID == segments[0] {
+			writeData(w, e)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serveRegions(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 1 {
+		return false
+	}
+
+	for _, reg := range s.data.Regions {
+		if reg.ID == segments[0] {
+			writeData(w, reg)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serveGenres(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch len(segments) {
+	case 0:
+		var items []interface{}
+		for _, g := range s.data.Genres {
+			items = append(items, g)
+		}
+
+		orders := map[string]lessFunc{
+			"name": func(a, b interface{}) bool {
+				return strings.ToLower(a.(*speedrun.Genre).Name) < strings.ToLower(b.(*speedrun.Genre).Name)
+			},
+		}
+		if sortItems(w, r, items, orders, "name") {
+			writePage(w, r, items)
+		}
+		return true
+	case 1:
+		for _, g := range s.data.Genres {
+			if g.ID == segments[0] {
+				writeData(w, g)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) serveGameTypes(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch len(segments) {
+	case 0:
+		var items []interface{}
+		for _, t := range s.data.GameTypes {
+			items = append(items, t)
+		}
+
+		orders := map[string]lessFunc{
+			"name": func(a, b interface{}) bool {
+				return strings.ToLower(a.(*speedrun.GameType).Name) < strings.ToLower(b.(*speedrun.GameType).Name)
+			},
+		}
+		if sortItems(w, r, items, orders, "name") {
+			writePage(w, r, items)
+		}
+		return true
+	case 1:
+		for _, t := range s.data.GameTypes {
+			if t.ID == segments[0] {
+				writeData(w, t)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) serveDevelopers(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch len(segments) {
+	case 0:
+		var items []interface{}
+		for _, d := range s.data.Developers {
+			items = append(items, d)
+		}
+
+		orders := map[string]lessFunc{
+			"name": func(a, b interface{}) bool {
+				return strings.ToLower(a.(*speedrun.Developer).Name) < strings.ToLower(b.(*speedrun.Developer).Name)
+			},
+		}
+		if sortItems(w, r, items, orders, "name") {
+			writePage(w, r, items)
+		}
+		return true
+	case 1:
+		for _, d := range s.data.Developers {
+			if d.ID == segments[0] {
+				writeData(w, d)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) servePublishers(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch len(segments) {
+	case 0:
+		var items []interface{}
+		for _, p := range s.data.Publishers {
+			items = append(items, p)
+		}
+
+		orders := map[string]lessFunc{
+			"name": func(a, b interface{}) bool {
+				return strings.ToLower(a.(*speedrun.Publisher).Name) < strings.ToLower(b.(*speedrun.Publisher).Name)
+			},
+		}
+		if sortItems(w, r, items, orders, "name") {
+			writePage(w, r, items)
+		}
+		return true
+	case 1:
+		for _, p := range s.data.Publishers {
+			if p.ID == segments[0] {
+				writeData(w, p)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) servePlatforms(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch len(segments) {
+	case 0:
+		var items []interface{}
+		for _, p := range s.data.Platforms {
+			items = append(items, p)
+		}
+
+		orders := map[string]lessFunc{
+			"name": func(a, b interface{}) bool {
+				return strings.ToLower(a.(*speedrun.Platform).Name) < strings.ToLower(b.(*speedrun.Platform).Name)
+			},
+			"released": func(a, b interface{}) bool {
+				return a.(*speedrun.Platform).Released < b.(*speedrun.Platform).Released
+			},
+		}
+		if sortItems(w, r, items, orders, "name") {
+			writePage(w, r, items)
+		}
+		return true
+	case 1:
+		for _, p := range s.data.Platforms {
+			if p.ID == segments[0] {
+				writeData(w, p)
+				return true
+			}
+		}
+	}
+	return false
+}
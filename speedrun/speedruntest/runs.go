@@ -0,0 +1,138 @@
+package speedruntest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+var runOrders = map[string]lessFunc{
+	"game": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).GameID < b.(*speedrun.Run).GameID
+	},
+	"category": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).CategoryID < b.(*speedrun.Run).CategoryID
+	},
+	"level": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).LevelID < b.(*speedrun.Run).LevelID
+	},
+	"platform": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).System.PlatformID < b.(*speedrun.Run).System.PlatformID
+	},
+	"region": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).System.RegionID < b.(*speedrun.Run).System.RegionID
+	},
+	"emulated": func(a, b interface{}) bool {
+		return !a.(*speedrun.Run).System.Emulated && b.(*speedrun.Run).System.Emulated
+	},
+	"date": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).Date < b.(*speedrun.Run).Date
+	},
+	"submitted": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).Submitted < b.(*speedrun.Run).Submitted
+	},
+	"status": func(a, b interface{}) bool {
+		return a.(*speedrun.Run).Status.Status < b.(*speedrun.Run).Status.Status
+	},
+	"verify-date": func(a, b interface{}) bool {
+		da, db := a.(*speedrun.Run).Status.VerifyDate, b.(*speedrun.Run).Status.VerifyDate
+		if da == nil || db == nil {
+			return da == nil && db != nil
+		}
+		return da.Before(*db)
+	},
+}
+
+func (s *Server) serveRuns(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch len(segments) {
+	case 0:
+		s.listRuns(w, r)
+		return true
+	case 1:
+		run := s.data.run(segments[0])
+		if run == nil {
+			return false
+		}
+
+		writeData(w, s.embedRun(run, embeds(r)))
+		return true
+	}
+	return false
+}
+
+func (s *Server) listRuns(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	emulated, err := boolParam(q, "emulated")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var items []interface{}
+	for _, run := range s.data.Runs {
+		if user := q.Get("user"); user != "" && !hasPlayer(run, speedrun.PlayerUser, user) {
+			continue
+		}
+		if guest := q.Get("guest"); guest != "" && !hasPlayer(run, speedrun.PlayerGuest, guest) {
+			continue
+		}
+		if examiner := q.Get("examiner"); examiner != "" && run.Status.ExaminerID != examiner {
+			continue
+		}
+		if game := q.Get("game"); game != "" && run.GameID != game {
+			continue
+		}
+		if level := q.Get("level"); level != "" && run.LevelID != level {
+			continue
+		}
+		if category := q.Get("category"); category != "" && run.CategoryID != category {
+			continue
+		}
+		if platform := q.Get("platform"); platform != "" && run.System.PlatformID != platform {
+			continue
+		}
+		if region := q.Get("region"); region != "" && run.System.RegionID != region {
+			continue
+		}
+		if emulated != nil && run.System.Emulated != *emulated {
+			continue
+		}
+		if status := q.Get("status"); status != "" && string(run.Status.Status) != status {
+			continue
+		}
+
+		items = append(items, run)
+	}
+
+	if !sortItems(w, r, items, runOrders, "game") {
+		return
+	}
+
+	es := embeds(r)
+	for i, item := range items {
+		items[i] = s.embedRun(item.(*speedrun.Run), es)
+	}
+	writePage(w, r, items)
+}
+
+func hasPlayer(run *speedrun.Run, rel speedrun.RunPlayerRel, id string) bool {
+	for _, p := range run.Players {
+		if p.Rel != rel {
+			continue
+		}
+
+		switch rel {
+		case speedrun.PlayerUser:
+			if p.ID == id {
+				return true
+			}
+		case speedrun.PlayerGuest:
+			if strings.EqualFold(p.Name, id) {
+				return true
+			}
+		}
+	}
+	return false
+}
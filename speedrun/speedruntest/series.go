@@ -0,0 +1,77 @@
+package speedruntest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+var seriesOrders = map[string]lessFunc{
+	"name.int": func(a, b interface{}) bool {
+		return strings.ToLower(a.(*speedrun.Series).Names.International) < strings.ToLower(b.(*speedrun.Series).Names.International)
+	},
+	"name.jap": func(a, b interface{}) bool {
+		return a.(*speedrun.Series).Names.Japanese < b.(*speedrun.Series).Names.Japanese
+	},
+	"abbreviation": func(a, b interface{}) bool {
+		return a.(*speedrun.Series).Abbreviation < b.(*speedrun.Series).Abbreviation
+	},
+	// Series are kept in the order they were created.
+	"created": func(a, b interface{}) bool {
+		return false
+	},
+}
+
+func (s *Server) serveSeries(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) == 0 {
+		s.listSeries(w, r)
+		return true
+	}
+
+	series := s.data.series(segments[0])
+	if series == nil {
+		return false
+	}
+
+	switch {
+	case len(segments) == 1:
+		writeData(w, series)
+	case len(segments) == 2 && segments[1] == "games":
+		var games []*speedrun.Game
+		for _, g := range s.data.Games {
+			if linkedID(g.Links, "series") == series.ID {
+				games = append(games, g)
+			}
+		}
+		s.listGames(w, r, games)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) listSeries(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var items []interface{}
+	for _, series := range s.data.Series {
+		if name := q.Get("name"); name != "" && !containsFold(series.Names.International, name) {
+			continue
+		}
+		if abbr := q.Get("abbreviation"); abbr != "" && !strings.EqualFold(series.Abbreviation, abbr) {
+			continue
+		}
+		if mod := q.Get("moderator"); mod != "" {
+			if _, ok := series.Moderators[mod]; !ok {
+				continue
+			}
+		}
+
+		items = append(items, series)
+	}
+
+	if sortItems(w, r, items, seriesOrders, "name.int") {
+		writePage(w, r, items)
+	}
+}
@@ -0,0 +1,332 @@
+// Package speedruntest provides a fake speedrun.com API for tests.
+package speedruntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 200
+)
+
+// Server is an in-process HTTP server that implements the parts of the
+// speedrun.com v1 API used by speedrun.Client, serving the data in a Dataset.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.RWMutex
+	data     *Dataset
+	failures map[string]int
+
+	requests int64
+}
+
+// NewServer starts a server for data. Callers should call Close when done.
+func NewServer(data *Dataset) *Server {
+	if data == nil {
+		data = &Dataset{}
+	}
+
+	s := &Server{data: data}
+	s.Server = httptest.NewServer(http.StripPrefix("/api/v1", http.HandlerFunc(s.serve)))
+	return s
+}
+
+// BaseURL is the base URL of the API, as used by speedrun.NewClient.
+func (s *Server) BaseURL() string {
+	return s.URL + "/api/v1"
+}
+
+// NewClient creates a client for the server. It doesn't cache responses or
+// limit its request rate, so tests always see the current data.
+func (s *Server) NewClient() *speedrun.Client {
+	c := speedrun.NewClient(s.BaseURL())
+	c.HTTPClient = s.Client()
+	c.Cache = nil
+	c.RequestsPerMinute = 0
+	c.MaxConcurrentRequests = 0
+	c.MaxRetries = 0
+	return c
+}
+
+// Update calls fn to change the server's data while no requests are being served.
+func (s *Server) Update(fn func(data *Dataset)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(s.data)
+}
+
+// Fail makes the server respond with status to requests for path and anything
+// under it, like "/games/sm64/categories", instead of serving the resource.
+// Calling it again with a status of zero serves the resource again.
+func (s *Server) Fail(path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures == nil {
+		s.failures = make(map[string]int)
+	}
+	path = "/" + strings.Trim(path, "/")
+	if status == 0 {
+		delete(s.failures, path)
+	} else {
+		s.failures[path] = status
+	}
+}
+
+// failure returns the status the server was told to respond to path with, if any.
+func (s *Server) failure(path string) int {
+	for path != "" && path != "/" {
+		if status, ok := s.failures[path]; ok {
+			return status
+		}
+		path = path[:strings.LastIndex(path, "/")]
+	}
+	return 0
+}
+
+// Requests returns the number of requests the server has received.
+func (s *Server) Requests() int {
+	return int(atomic.LoadInt64(&s.requests))
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt64(&s.requests, 1)
+
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "The requested method is not supported for this resource.")
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if status := s.failure("/" + strings.Trim(r.URL.Path, "/")); status != 0 {
+		writeError(w, status, http.StatusText(status))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var ok bool
+	switch segments[0] {
+	case "categories":
+		ok = s.serveCategories(w, r, segments[1:])
+	case "developers":
+		ok = s.serveDevelopers(w, r, segments[1:])
+	case "engines":
+		ok = s.serveEngines(w, r, segments[1:])
+	case "games":
+		ok = s.serveGames(w, r, segments[1:])
+	case "gametypes":
+		ok = s.serveGameTypes(w, r, segments[1:])
+	case "genres":
+		ok = s.serveGenres(w, r, segments[1:])
+	case "guests":
+		ok = s.serveGuests(w, r, segments[1:])
+	case "leaderboards":
+		ok = s.serveLeaderboards(w, r, segments[1:])
+	case "levels":
+		ok = s.serveLevels(w, r, segments[1:])
+	case "notifications":
+		ok = s.serveNotifications(w, r, segments[1:])
+	case "platforms":
+		ok = s.servePlatforms(w, r, segments[1:])
+	case "profile":
+		ok = s.serveProfile(w, r, segments[1:])
+	case "publishers":
+		ok = s.servePublishers(w, r, segments[1:])
+	case "regions":
+		ok = s.serveRegions(w, r, segments[1:])
+	case "runs":
+		ok = s.serveRuns(w, r, segments[1:])
+	case "series":
+		ok = s.serveSeries(w, r, segments[1:])
+	case "users":
+		ok = s.serveUsers(w, r, segments[1:])
+	case "variables":
+		ok = s.serveVariables(w, r, segments[1:])
+	}
+
+	if !ok {
+		writeNotFound(w)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"status":  status,
+		"message": message,
+		"links":   []speedrun.Link{},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "The requested resource could not be found.")
+}
+
+// writePage writes the page of items selected by the offset and max query
+// parameters, with pagination info and links like speedrun.com's.
+func writePage(w http.ResponseWriter, r *http.Request, items []interface{}) {
+	q := r.URL.Query()
+
+	offset, err := intParam(q, "offset", 0)
+	if err != nil || offset < 0 {
+		writeError(w, http.StatusBadRequest, "Invalid offset value.")
+		return
+	}
+	max, err := intParam(q, "max", defaultPageSize)
+	if err != nil || max < 1 || max > maxPageSize {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("The max value must be between 1 and %d.", maxPageSize))
+		return
+	}
+
+	start := offset
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + max
+	if end > len(items) {
+		end = len(items)
+	}
+	page := items[start:end]
+	if page == nil {
+		page = []interface{}{}
+	}
+
+	pi := speedrun.PageInfo{
+		Offset: offset,
+		Max:    max,
+		Size:   len(page),
+		Links:  []speedrun.Link{},
+	}
+	if offset > 0 {
+		prev := offset - max
+		if prev < 0 {
+			prev = 0
+		}
+		pi.Links = append(pi.Links, speedrun.Link{Rel: "prev", URI: pageURL(r, prev)})
+	}
+	if end < len(items) {
+		pi.Links = append(pi.Links, speedrun.Link{Rel: "next", URI: pageURL(r, end)})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":       page,
+		"pagination": pi,
+	})
+}
+
+func pageURL(r *http.Request, offset int) string {
+	q := r.URL.Query()
+	q.Set("offset", strconv.Itoa(offset))
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     r.Host,
+		Path:     "/api/v1" + r.URL.Path,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+func intParam(q url.Values, name string, def int) (int, error) {
+	s := q.Get(name)
+	if s == "" {
+		return def, nil
+	}
+	return strconv.Atoi(s)
+}
+
+func boolParam(q url.Values, name string) (*bool, error) {
+	s := q.Get(name)
+	if s == "" {
+		return nil, nil
+	}
+
+	switch strings.ToLower(s) {
+	case "yes", "true", "1":
+		b := true
+		return &b, nil
+	case "no", "false", "0":
+		b := false
+		return &b, nil
+	default:
+		return nil, fmt.Errorf("invalid boolean value %q for %s", s, name)
+	}
+}
+
+type lessFunc func(a, b interface{}) bool
+
+// sortItems orders items by the orderby and direction query parameters,
+// using orders to compare items for each supported orderby value.
+func sortItems(w http.ResponseWriter, r *http.Request, items []interface{}, orders map[string]lessFunc, defaultOrder string) bool {
+	q := r.URL.Query()
+
+	orderBy := q.Get("orderby")
+	if orderBy == "" {
+		orderBy = defaultOrder
+	}
+	less, ok := orders[orderBy]
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid orderby value %q.", orderBy))
+		return false
+	}
+
+	var desc bool
+	switch q.Get("direction") {
+	case "", "asc":
+	case "desc":
+		desc = true
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid direction value %q.", q.Get("direction")))
+		return false
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if desc {
+			return less(items[j], items[i])
+		}
+		return less(items[i], items[j])
+	})
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
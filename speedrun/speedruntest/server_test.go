@@ -0,0 +1,275 @@
+package speedruntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+func testDataset() *Dataset {
+	d := &Dataset{
+		Platforms: []*speedrun.Platform{{ID: "n64", Name: "Nintendo 64"}},
+		Games: []*speedrun.Game{
+			{ID: "sm64", Names: speedrun.GameNames{International: "Super Mario 64"}, Abbreviation: "sm64", ReleaseDate: "1996-06-23", Platforms: []string{"n64"}},
+			{ID: "oot", Names: speedrun.GameNames{International: "Ocarina of Time"}, Abbreviation: "oot", ReleaseDate: "1998-11-21", Platforms: []string{"n64"}},
+		},
+		Categories: []*speedrun.Category{
+			{ID: "120", Name: "120 Star", Type: speedrun.CategoryPerGame, Links: []speedrun.Link{{Rel: "game", URI: "/games/sm64"}}},
+		},
+		Users: []*speedrun.User{
+			{ID: "u1", Names: speedrun.UserNames{International: "Alice"}},
+			{ID: "u2", Names: speedrun.UserNames{International: "Bob"}},
+		},
+		Runs: []*speedrun.Run{
+			{ID: "r1", GameID: "sm64", CategoryID: "120", Status: speedrun.RunStatus{Status: speedrun.RunVerified}, Players: []speedrun.RunPlayer{{Rel: speedrun.PlayerUser, ID: "u1"}}, Times: speedrun.RunTimes{Primary: 6000}, System: speedrun.RunSystem{PlatformID: "n64"}},
+			{ID: "r2", GameID: "sm64", CategoryID: "120", Status: speedrun.RunStatus{Status: speedrun.RunVerified}, Players: []speedrun.RunPlayer{{Rel: speedrun.PlayerUser, ID: "u2"}}, Times: speedrun.RunTimes{Primary: 5900}, System: speedrun.RunSystem{PlatformID: "n64"}},
+			{ID: "r3", GameID: "sm64", CategoryID: "120", Status: speedrun.RunStatus{Status: speedrun.RunNew}, Players: []speedrun.RunPlayer{{Rel: speedrun.PlayerGuest, Name: "Guesty"}}, Times: speedrun.RunTimes{Primary: 5800}},
+		},
+	}
+	for i := 0; i < 43; i++ {
+		d.Games = append(d.Games, &speedrun.Game{ID: fmt.Sprintf("g%02d", i), Names: speedrun.GameNames{International: fmt.Sprintf("Game %02d", i)}})
+	}
+	return d
+}
+
+type page struct {
+	Data       []json.RawMessage `json:"data"`
+	Pagination speedrun.PageInfo `json:"pagination"`
+}
+
+// get fetches path from the server, decoding the response into v, and returns
+// the response's status code.
+func get(t *testing.T, s *Server, path string, v interface{}) int {
+	t.Helper()
+
+	res, err := http.Get(s.BaseURL() + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		t.Fatalf("decoding %s: %v", path, err)
+	}
+	return res.StatusCode
+}
+
+func ids(t *testing.T, items []json.RawMessage) []string {
+	t.Helper()
+
+	var res []string
+	for _, item := range items {
+		var v struct{ ID string }
+		if err := json.Unmarshal(item, &v); err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, v.ID)
+	}
+	return res
+}
+
+func TestPaging(t *testing.T) {
+	s := NewServer(testDataset())
+	defer s.Close()
+
+	var p page
+	get(t, s, "/games", &p)
+	if len(p.Data) != defaultPageSize || p.Pagination.Size != defaultPageSize || p.Pagination.Max != defaultPageSize {
+		t.Errorf("first page has %d games, size %d and max %d, want %d", len(p.Data), p.Pagination.Size, p.Pagination.Max, defaultPageSize)
+	}
+	if next := speedrun.FindLink(p.Pagination.Links, "next"); next == "" {
+		t.Errorf("first page has no next link")
+	} else if u, _ := url.Parse(next); u.Query().Get("offset") != "20" {
+		t.Errorf("next link = %q, want offset 20", next)
+	}
+	if prev := speedrun.FindLink(p.Pagination.Links, "prev"); prev != "" {
+		t.Errorf("first page has prev link %q", prev)
+	}
+
+	p = page{}
+	get(t, s, "/games?offset=40&max=10", &p)
+	if len(p.Data) != 5 || p.Pagination.Offset != 40 || p.Pagination.Size != 5 {
+		t.Errorf("last page has %d games at offset %d with size %d, want 5 at 40", len(p.Data), p.Pagination.Offset, p.Pagination.Size)
+	}
+	if next := speedrun.FindLink(p.Pagination.Links, "next"); next != "" {
+		t.Errorf("last page has next link %q", next)
+	}
+	if prev := speedrun.FindLink(p.Pagination.Links, "prev"); prev == "" {
+		t.Errorf("last page has no prev link")
+	}
+
+	p = page{}
+	get(t, s, "/games?offset=100", &p)
+	if len(p.Data) != 0 || p.Data == nil {
+		t.Errorf("page past the end = %v, want an empty list", p.Data)
+	}
+
+	var errResp struct{ Message string }
+	if status := get(t, s, "/games?max=201", &errResp); status != http.StatusBadRequest {
+		t.Errorf("max=201 returned status %d, want %d", status, http.StatusBadRequest)
+	}
+	if status := get(t, s, "/games?offset=-1", &errResp); status != http.StatusBadRequest {
+		t.Errorf("offset=-1 returned status %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	s := NewServer(testDataset())
+	defer s.Close()
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"/games?max=3", []string{"g00", "g01", "g02"}},
+		{"/games?max=3&orderby=name.int&direction=desc", []string{"sm64", "oot", "g42"}},
+		{"/games?max=2&orderby=released&direction=desc", []string{"oot", "sm64"}},
+		{"/users?orderby=name.int&direction=desc", []string{"u2", "u1"}},
+		{"/runs?orderby=status", []string{"r3", "r1", "r2"}},
+	}
+
+	for _, tt := range tests {
+		var p page
+		if status := get(t, s, tt.path, &p); status != http.StatusOK {
+			t.Errorf("%s returned status %d", tt.path, status)
+			continue
+		}
+		if got := ids(t, p.Data); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	for _, path := range []string{"/games?orderby=nope", "/games?direction=sideways"} {
+		var errResp struct{ Message string }
+		if status := get(t, s, path, &errResp); status != http.StatusBadRequest {
+			t.Errorf("%s returned status %d, want %d", path, status, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestFilters(t *testing.T) {
+	s := NewServer(testDataset())
+	defer s.Close()
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"/games?name=mario", []string{"sm64"}},
+		{"/games?abbreviation=OOT", []string{"oot"}},
+		{"/games?released=1998", []string{"oot"}},
+		{"/games?platform=n64&orderby=released", []string{"sm64", "oot"}},
+		{"/users?lookup=alice", []string{"u1"}},
+		{"/runs?user=u2", []string{"r2"}},
+		{"/runs?guest=guesty", []string{"r3"}},
+		{"/runs?status=verified", []string{"r1", "r2"}},
+		{"/runs?platform=n64&user=u1", []string{"r1"}},
+		{"/runs?game=oot", nil},
+	}
+
+	for _, tt := range tests {
+		var p page
+		if status := get(t, s, tt.path, &p); status != http.StatusOK {
+			t.Errorf("%s returned status %d", tt.path, status)
+			continue
+		}
+		if got := ids(t, p.Data); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	s := NewServer(testDataset())
+	defer s.Close()
+
+	for _, path := range []string{"/games/nope", "/runs/nope", "/nope", "/guests/nobody"} {
+		var errResp struct{ Status int }
+		if status := get(t, s, path, &errResp); status != http.StatusNotFound || errResp.Status != http.StatusNotFound {
+			t.Errorf("%s returned status %d (%d in body), want %d", path, status, errResp.Status, http.StatusNotFound)
+		}
+	}
+}
+
+func TestEmbeds(t *testing.T) {
+	s := NewServer(testDataset())
+	defer s.Close()
+
+	var run struct {
+		Data struct {
+			ID       string
+			Game     struct{ Data speedrun.Game }
+			Level    struct{ Data []interface{} }
+			Players  struct{ Data []map[string]interface{} }
+			Platform struct{ Data speedrun.Platform }
+		}
+	}
+	get(t, s, "/runs/r1?embed=game,level,players,platform", &run)
+	if run.Data.Game.Data.ID != "sm64" {
+		t.Errorf("embedded game = %q, want sm64", run.Data.Game.Data.ID)
+	}
+	if run.Data.Level.Data == nil || len(run.Data.Level.Data) != 0 {
+		t.Errorf("embedded missing level = %v, want an empty list", run.Data.Level.Data)
+	}
+	if len(run.Data.Players.Data) != 1 || run.Data.Players.Data[0]["id"] != "u1" || run.Data.Players.Data[0]["rel"] != "user" {
+		t.Errorf("embedded players = %v, want user u1", run.Data.Players.Data)
+	}
+	if run.Data.Platform.Data.ID != "n64" {
+		t.Errorf("embedded platform = %q, want n64", run.Data.Platform.Data.ID)
+	}
+
+	var plain struct {
+		Data struct{ Game string }
+	}
+	get(t, s, "/runs/r1", &plain)
+	if plain.Data.Game != "sm64" {
+		t.Errorf("unembedded game = %q, want the game's ID", plain.Data.Game)
+	}
+
+	var lb struct {
+		Data struct {
+			Runs    []speedrun.PlacedRun
+			Players struct{ Data []map[string]interface{} }
+		}
+	}
+	get(t, s, "/leaderboards/sm64/category/120?embed=players", &lb)
+	if len(lb.Data.Runs) != 2 || lb.Data.Runs[0].Run.ID != "r2" || lb.Data.Runs[0].Place != 1 {
+		t.Fatalf("leaderboard runs = %v, want r2 first of 2 verified runs", lb.Data.Runs)
+	}
+	var players []interface{}
+	for _, p := range lb.Data.Players.Data {
+		players = append(players, p["id"])
+	}
+	if !reflect.DeepEqual(players, []interface{}{"u2", "u1"}) {
+		t.Errorf("leaderboard players = %v, want [u2 u1]", players)
+	}
+}
+
+func TestFail(t *testing.T) {
+	s := NewServer(testDataset())
+	defer s.Close()
+
+	s.Fail("/games/sm64", 420)
+
+	var errResp struct{ Status int }
+	for _, path := range []string{"/games/sm64", "/games/sm64/categories"} {
+		if status := get(t, s, path, &errResp); status != 420 || errResp.Status != 420 {
+			t.Errorf("%s returned status %d (%d in body), want 420", path, status, errResp.Status)
+		}
+	}
+	for _, path := range []string{"/games/oot", "/games/sm64x"} {
+		if status := get(t, s, path, &errResp); status == 420 {
+			t.Errorf("%s returned status 420, want it to be served", path)
+		}
+	}
+
+	s.Fail("/games/sm64", 0)
+	if status := get(t, s, "/games/sm64", &errResp); status != http.StatusOK {
+		t.Errorf("/games/sm64 returned status %d after it stopped failing, want %d", status, http.StatusOK)
+	}
+}
@@ -0,0 +1,163 @@
+package speedruntest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mjm/speedrungql/speedrun"
+)
+
+var userOrders = map[string]lessFunc{
+	"name.int": func(a, b interface{}) bool {
+		return strings.ToLower(a.(*speedrun.User).Names.International) < strings.ToLower(b.(*speedrun.User).Names.International)
+	},
+	"name.jap": func(a, b interface{}) bool {
+		return a.(*speedrun.User).Names.Japanese < b.(*speedrun.User).Names.Japanese
+	},
+	"signup": func(a, b interface{}) bool {
+		sa, sb := a.(*speedrun.User).Signup, b.(*speedrun.User).Signup
+		if sa == nil || sb == nil {
+			return sa == nil && sb != nil
+		}
+		return sa.Before(*sb)
+	},
+	"role": func(a, b interface{}) bool {
+		return a.(*speedrun.User).Role < b.(*speedrun.User).Role
+	},
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) == 0 {
+		s.listUsers(w, r)
+		return true
+	}
+
+	u := s.data.user(segments[0])
+	if u == nil {
+		return false
+	}
+
+	switch {
+	case len(segments) == 1:
+		writeData(w, u)
+	case len(segments) == 2 && segments[1] == "personal-bests":
+		s.writePersonalBests(w, r, u)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveProfile(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 0 {
+		return false
+	}
+
+	u := s.authenticate(w, r)
+	if u != nil {
+		writeData(w, u)
+	}
+	return true
+}
+
+var notificationOrders = map[string]lessFunc{
+	"created": func(a, b interface{}) bool {
+		ca, cb := a.(*speedrun.Notification).Created, b.(*speedrun.Notification).Created
+		if ca == nil || cb == nil {
+			return ca == nil && cb != nil
+		}
+		return ca.Before(*cb)
+	},
+}
+
+func (s *Server) serveNotifications(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 0 {
+		return false
+	}
+
+	u := s.authenticate(w, r)
+	if u == nil {
+		return true
+	}
+
+	var items []interface{}
+	for _, n := range s.data.Notifications[u.ID] {
+		items = append(items, n)
+	}
+
+	if sortItems(w, r, items, notificationOrders, "created") {
+		writePage(w, r, items)
+	}
+	return true
+}
+
+// authenticate finds the user whose API key the request was made with. If
+// there isn't one, it writes an error response and returns nil.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) *speedrun.User {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		writeError(w, http.StatusUnauthorized, "You must authenticate to access this resource.")
+		return nil
+	}
+
+	userID, ok := s.data.APIKeys[key]
+	if !ok {
+		writeError(w, http.StatusForbidden, "The API key is not valid.")
+		return nil
+	}
+
+	u := s.data.user(userID)
+	if u == nil {
+		writeError(w, http.StatusForbidden, "The API key is not valid.")
+		return nil
+	}
+	return u
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var items []interface{}
+	for _, u := range s.data.Users {
+		if lookup := q.Get("lookup"); lookup != "" {
+			if !strings.EqualFold(u.Names.International, lookup) &&
+				!strings.EqualFold(u.Names.Japanese, lookup) &&
+				!linkNamed(u.Twitch, lookup) &&
+				!linkNamed(u.Hitbox, lookup) &&
+				!linkNamed(u.Twitter, lookup) &&
+				!linkNamed(u.SpeedRunsLive, lookup) {
+				continue
+			}
+		}
+		if name := q.Get("name"); name != "" && !containsFold(u.Names.International, name) && !containsFold(u.Names.Japanese, name) {
+			continue
+		}
+		if twitch := q.Get("twitch"); twitch != "" && !linkNamed(u.Twitch, twitch) {
+			continue
+		}
+		if hitbox := q.Get("hitbox"); hitbox != "" && !linkNamed(u.Hitbox, hitbox) {
+			continue
+		}
+		if twitter := q.Get("twitter"); twitter != "" && !linkNamed(u.Twitter, twitter) {
+			continue
+		}
+		if srl := q.Get("speedrunslive"); srl != "" && !linkNamed(u.SpeedRunsLive, srl) {
+			continue
+		}
+
+		items = append(items, u)
+	}
+
+	if sortItems(w, r, items, userOrders, "name.int") {
+		writePage(w, r, items)
+	}
+}
+
+// linkNamed reports whether link points to the profile called name on some
+// other site.
+func linkNamed(link *speedrun.Link, name string) bool {
+	if link == nil {
+		return false
+	}
+	return strings.HasSuffix(strings.ToLower(strings.TrimRight(link.URI, "/")), "/"+strings.ToLower(name))
+}
@@ -32,6 +32,16 @@ type Engine struct {
 	Name string `json:"name"`
 }
 
+type DevelopersResponse struct {
+	Data       []*Developer `json:"data"`
+	Pagination *PageInfo    `json:"pagination"`
+}
+
+type Developer struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type GamesResponse struct {
 	Data       []*Game   `json:"data"`
 	Pagination *PageInfo `json:"pagination"`
@@ -48,8 +58,12 @@ type Game struct {
 	Regions      []string                     `json:"regions"`
 	Genres       []string                     `json:"genres"`
 	Engines      []string                     `json:"engines"`
+	GameTypes    []string                     `json:"gametypes"`
+	Developers   []string                     `json:"developers"`
+	Publishers   []string                     `json:"publishers"`
 	Moderators   map[string]GameModeratorRole `json:"moderators"`
 	Assets       map[GameAssetKind]*GameAsset `json:"assets"`
+	Links        []Link                       `json:"links"`
 }
 
 type GameNames struct {
@@ -139,6 +153,17 @@ const (
 	PlayersUpTo    CategoryPlayersType = "up-to"
 )
 
+type GameTypesResponse struct {
+	Data       []*GameType `json:"data"`
+	Pagination *PageInfo   `json:"pagination"`
+}
+
+type GameType struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	AllowsBaseGame bool   `json:"allows-base-game"`
+}
+
 type GenresResponse struct {
 	Data       []*Genre  `json:"data"`
 	Pagination *PageInfo `json:"pagination"`
@@ -149,6 +174,11 @@ type Genre struct {
 	Name string `json:"name"`
 }
 
+type Guest struct {
+	Name  string `json:"name"`
+	Links []Link `json:"links"`
+}
+
 type LevelsResponse struct {
 	Data []*Level `json:"data"`
 }
@@ -158,9 +188,30 @@ type Level struct {
 	Name    string `json:"name"`
 	Weblink string `json:"weblink"`
 	Rules   string `json:"rules"`
-	Links   []Link `json:"link"`
+	Links   []Link `json:"links"`
+}
+
+type NotificationsResponse struct {
+	Data       []*Notification `json:"data"`
+	Pagination *PageInfo       `json:"pagination"`
 }
 
+type Notification struct {
+	ID      string             `json:"id"`
+	Created *time.Time         `json:"created"`
+	Status  NotificationStatus `json:"status"`
+	Text    string             `json:"text"`
+	Item    Link               `json:"item"`
+	Links   []Link             `json:"links"`
+}
+
+type NotificationStatus string
+
+const (
+	NotificationRead   NotificationStatus = "read"
+	NotificationUnread NotificationStatus = "unread"
+)
+
 type PlatformsResponse struct {
 	Data       []*Platform `json:"data"`
 	Pagination *PageInfo   `json:"pagination"`
@@ -176,16 +227,34 @@ type Platform struct {
 	Released int32  `json:"released"`
 }
 
+type PublishersResponse struct {
+	Data       []*Publisher `json:"data"`
+	Pagination *PageInfo    `json:"pagination"`
+}
+
+type Publisher struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type LeaderboardResponse struct {
 	Data *Leaderboard `json:"data"`
 }
 
+type LeaderboardsResponse struct {
+	Data       []*Leaderboard `json:"data"`
+	Pagination *PageInfo      `json:"pagination"`
+}
+
 type Leaderboard struct {
 	GameID     string      `json:"game"`
 	CategoryID string      `json:"category"`
 	LevelID    string      `json:"level"`
 	Timing     GameRunTime `json:"timing"`
 	Runs       []PlacedRun `json:"runs"`
+
+	embeds          []embedded
+	playersEmbedded bool
 }
 
 type PlacedRunsResponse struct {
@@ -195,6 +264,8 @@ type PlacedRunsResponse struct {
 type PlacedRun struct {
 	Place int  `json:"place"`
 	Run   *Run `json:"run"`
+
+	embeds []embedded
 }
 
 type RunsResponse struct {
@@ -214,8 +285,11 @@ type Run struct {
 	Submitted  string            `json:"submitted"`
 	Players    []RunPlayer       `json:"players"`
 	Times      RunTimes          `json:"times"`
+	System     RunSystem         `json:"system"`
 	Splits     *Link             `json:"splits"`
 	Values     map[string]string `json:"values"`
+
+	embeds []embedded
 }
 
 type RunVideos struct {
@@ -259,11 +333,32 @@ type RunTimes struct {
 	InGame          float64 `json:"ingame_t"`
 }
 
+type RunSystem struct {
+	PlatformID string `json:"platform"`
+	Emulated   bool   `json:"emulated"`
+	RegionID   string `json:"region"`
+}
+
 type Region struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
 
+type SeriesResponse struct {
+	Data       []*Series `json:"data"`
+	Pagination *PageInfo `json:"pagination"`
+}
+
+type Series struct {
+	ID           string                       `json:"id"`
+	Names        GameNames                    `json:"names"`
+	Abbreviation string                       `json:"abbreviation"`
+	Weblink      string                       `json:"weblink"`
+	Moderators   map[string]GameModeratorRole `json:"moderators"`
+	Assets       map[GameAssetKind]*GameAsset `json:"assets"`
+	Links        []Link                       `json:"links"`
+}
+
 type UsersResponse struct {
 	Data       []*User   `json:"data"`
 	Pagination *PageInfo `json:"pagination"`
@@ -276,6 +371,7 @@ type User struct {
 	NameStyle     UserNameStyle `json:"name-style"`
 	Role          UserRole      `json:"role"`
 	Signup        *time.Time    `json:"signup"`
+	Location      *UserLocation `json:"location"`
 	Twitch        *Link         `json:"twitch"`
 	Hitbox        *Link         `json:"hitbox"`
 	YouTube       *Link         `json:"youtube"`
@@ -288,6 +384,16 @@ type UserNames struct {
 	Japanese      string `json:"japanese"`
 }
 
+type UserLocation struct {
+	Country *Location `json:"country"`
+	Region  *Location `json:"region"`
+}
+
+type Location struct {
+	Code  string    `json:"code"`
+	Names UserNames `json:"names"`
+}
+
 type UserNameStyle struct {
 	Style     UserNameStyleValue `json:"style"`
 	Color     *Color             `json:"color"`